@@ -1,10 +1,11 @@
 package null
 
 import (
+	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/xml"
 	"fmt"
-	"strconv"
+	"log/slog"
 )
 
 // Int64 represents a int64 that may be null.
@@ -18,80 +19,148 @@ func NewInt64(i64 int64, valid bool) Int64 {
 	return Int64{Int64: i64, Valid: valid}
 }
 
-// Scan implements the Scanner interface.
-func (i *Int64) Scan(value interface{}) error {
-	if value == nil {
-		i.Int64, i.Valid = 0, false
-		return nil
+// Int64From creates a new Int64 that is always valid.
+func Int64From(i int64) Int64 {
+	return NewInt64(i, true)
+}
+
+// Int64FromPtr creates a new Int64 that is null if i is nil.
+func Int64FromPtr(i *int64) Int64 {
+	if i == nil {
+		return Int64{}
 	}
+	return NewInt64(*i, true)
+}
+
+// Int64FromSQL creates a new Int64 from a sql.NullInt64.
+func Int64FromSQL(i sql.NullInt64) Int64 {
+	return NewInt64(i.Int64, i.Valid)
+}
 
-	i.Valid = true
-	switch data := value.(type) {
-	case string:
-		i64, err := strconv.ParseInt(data, 10, 64)
-		if err != nil {
-			return err
-		}
-		i.Int64 = i64
-		return nil
-	case []byte:
-		i64, err := strconv.ParseInt(string(data), 10, 64)
-		if err != nil {
-			return err
-		}
-		i.Int64 = i64
-		return nil
-	case int:
-		i.Int64 = int64(data)
-		return nil
-	case int8:
-		i.Int64 = int64(data)
-		return nil
-	case int16:
-		i.Int64 = int64(data)
-		return nil
-	case int32:
-		i.Int64 = int64(data)
-		return nil
-	case int64:
-		i.Int64 = data
-		return nil
-	default:
-		return fmt.Errorf("unsupported type: %T", value)
+// Scan implements the Scanner interface.
+// Numbers of any integer type and floats with no fractional part are accepted,
+// and a number out of the range of Int64 is reported as an *OverflowError.
+func (i *Int64) Scan(value interface{}) error {
+	n := Value[int64]{}
+	if err := n.scan("Int64", value); err != nil {
+		return err
 	}
+	i.Int64, i.Valid = n.V, n.Valid
+	return nil
 }
 
 // Value implements the driver Valuer interface.
 func (i Int64) Value() (driver.Value, error) {
-	if !i.Valid {
-		return nil, nil
-	}
-	return i.Int64, nil
+	return Value[int64]{V: i.Int64, Valid: i.Valid}.Value()
 }
 
 // MarshalJSON encode the value to JSON.
 func (i Int64) MarshalJSON() ([]byte, error) {
-	if !i.Valid {
-		return []byte("null"), nil
-	}
-	return jsonMarshal(i.Int64)
+	return Value[int64]{V: i.Int64, Valid: i.Valid}.MarshalJSON()
 }
 
 // UnmarshalJSON decode data to the value.
+// A quoted value is accepted as well if LenientJSON is true.
 func (i *Int64) UnmarshalJSON(data []byte) error {
-	var i64 *int64
-	if err := json.Unmarshal(data, &i64); err != nil {
+	n := Value[int64]{}
+	if err := n.unmarshalJSON("Int64", data); err != nil {
+		return err
+	}
+	i.Int64, i.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (i Int64) MarshalText() ([]byte, error) {
+	return Value[int64]{V: i.Int64, Valid: i.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (i *Int64) UnmarshalText(text []byte) error {
+	n := Value[int64]{}
+	if err := n.unmarshalText("Int64", text); err != nil {
 		return err
 	}
-	i.Valid = i64 != nil
-	if i.Valid {
-		i.Int64 = *i64
-	} else {
-		i.Int64 = 0
+	i.Int64, i.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (i Int64) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i.Valid, i)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (i *Int64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, i)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (i Int64) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i.Valid, i)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (i *Int64) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (i Int64) MarshalYAML() (interface{}, error) {
+	return Value[int64]{V: i.Int64, Valid: i.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (i *Int64) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[int64]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
 	}
+	i.Int64, i.Valid = n.V, n.Valid
 	return nil
 }
 
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (i Int64) LogValue() slog.Value {
+	return Value[int64]{V: i.Int64, Valid: i.Valid}.LogValue()
+}
+
+// Format implements the fmt.Formatter interface.
+func (i Int64) Format(state fmt.State, verb rune) {
+	format(state, verb, i, i.Valid, i.Int64)
+}
+
+// String implements the fmt.Stringer interface.
+func (i Int64) String() string {
+	return fmt.Sprint(i)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (i Int64) Ptr() *int64 {
+	return Value[int64]{V: i.Int64, Valid: i.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (i Int64) ValueOrZero() int64 {
+	return Value[int64]{V: i.Int64, Valid: i.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (i Int64) ValueOr(v int64) int64 {
+	return Value[int64]{V: i.Int64, Valid: i.Valid}.ValueOr(v)
+}
+
+// ToSQL returns the value as a sql.NullInt64.
+func (i Int64) ToSQL() sql.NullInt64 {
+	return sql.NullInt64{Int64: i.Int64, Valid: i.Valid}
+}
+
 // IsNull returns true if Valid is false.
 func (i *Int64) IsNull() bool {
 	return !i.Valid
@@ -2,7 +2,9 @@ package null
 
 import (
 	"bytes"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 )
@@ -127,8 +129,8 @@ func TestInt64ScanStringByteError(t *testing.T) {
 func TestInt64ScanTypeError(t *testing.T) {
 	val := Int64{}
 	err := val.Scan(struct{}{})
-	if err == nil || err.Error() != "unsupported type: struct {}" {
-		t.Fatalf("want %v, but %v:", "unsupported type: struct {}", err)
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
 	}
 }
 
@@ -245,6 +247,63 @@ func TestInt64UnmarshalJSONError(t *testing.T) {
 	}
 }
 
+func TestInt64MarshalText(t *testing.T) {
+	val := NewInt64(-1, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `-1`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestInt64MarshalTextNull(t *testing.T) {
+	val := NewInt64(1, false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestInt64UnmarshalText(t *testing.T) {
+	var val Int64
+	if err := val.UnmarshalText([]byte(`-1`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewInt64(-1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt64UnmarshalTextEmpty(t *testing.T) {
+	val := NewInt64(1, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewInt64(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt64UnmarshalTextError(t *testing.T) {
+	val := Int64{}
+	err := val.UnmarshalText([]byte("foo"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
 func TestInt64IsNull(t *testing.T) {
 	val := NewInt64(0, true)
 	if val.IsNull() {
@@ -256,3 +315,81 @@ func TestInt64IsNull(t *testing.T) {
 		t.Fatal("it has to be not null")
 	}
 }
+
+func TestInt64From(t *testing.T) {
+	val := Int64From(int64(1))
+	want := NewInt64(int64(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt64FromPtr(t *testing.T) {
+	v := int64(1)
+	val := Int64FromPtr(&v)
+	want := NewInt64(int64(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = Int64FromPtr(nil)
+	want = Int64{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt64Ptr(t *testing.T) {
+	val := NewInt64(int64(1), true)
+	got := val.Ptr()
+	if got == nil || *got != int64(1) {
+		t.Fatalf("want %v, but %v:", int64(1), got)
+	}
+
+	val = NewInt64(int64(1), false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestInt64ValueOr(t *testing.T) {
+	val := NewInt64(int64(1), true)
+	if got := val.ValueOrZero(); got != int64(1) {
+		t.Fatalf("want %v, but %v:", int64(1), got)
+	}
+	if got := val.ValueOr(int64(2)); got != int64(1) {
+		t.Fatalf("want %v, but %v:", int64(1), got)
+	}
+
+	val = NewInt64(int64(1), false)
+	if got := val.ValueOrZero(); got != int64(0) {
+		t.Fatalf("want %v, but %v:", int64(0), got)
+	}
+	if got := val.ValueOr(int64(2)); got != int64(2) {
+		t.Fatalf("want %v, but %v:", int64(2), got)
+	}
+}
+
+func TestInt64FromSQL(t *testing.T) {
+	val := Int64FromSQL(sql.NullInt64{Int64: 1, Valid: true})
+	want := NewInt64(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = Int64FromSQL(sql.NullInt64{Int64: 1, Valid: false})
+	want = NewInt64(1, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt64ToSQL(t *testing.T) {
+	for _, valid := range []bool{true, false} {
+		got := NewInt64(1, valid).ToSQL()
+		want := sql.NullInt64{Int64: 1, Valid: valid}
+		if got != want {
+			t.Fatalf("want %v, but %v:", want, got)
+		}
+	}
+}
@@ -1,9 +1,11 @@
 package null
 
 import (
+	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"log/slog"
 	"strconv"
 )
 
@@ -18,123 +20,165 @@ func NewBool(b bool, valid bool) Bool {
 	return Bool{Bool: b, Valid: valid}
 }
 
-// Scan implements the Scanner interface.
-func (b *Bool) Scan(value interface{}) error {
-	if value == nil {
-		b.Bool, b.Valid = false, false
-		return nil
+// BoolFrom creates a new Bool that is always valid.
+func BoolFrom(b bool) Bool {
+	return NewBool(b, true)
+}
+
+// BoolFromPtr creates a new Bool that is null if b is nil.
+func BoolFromPtr(b *bool) Bool {
+	if b == nil {
+		return Bool{}
 	}
+	return NewBool(*b, true)
+}
 
-	b.Valid = true
-	switch data := value.(type) {
-	case string:
-		toBool, err := strconv.ParseBool(data)
-		if err != nil {
-			return err
-		}
-		b.Bool = toBool
-		return nil
-	case []byte:
-		toBool, err := strconv.ParseBool(string(data))
-		if err != nil {
-			return err
-		}
-		b.Bool = toBool
-		return nil
-	case uint8:
-		if data != 0 && data != 1 {
-			return fmt.Errorf("unsupported bool value: %d", value)
-		}
-		b.Bool = data == 1
-		return nil
-	case uint16:
-		if data != 0 && data != 1 {
-			return fmt.Errorf("unsupported bool value: %d", value)
-		}
-		b.Bool = data == 1
-		return nil
-	case uint32:
-		if data != 0 && data != 1 {
-			return fmt.Errorf("unsupported bool value: %d", value)
-		}
-		b.Bool = data == 1
-		return nil
-	case uint64:
-		if data != 0 && data != 1 {
-			return fmt.Errorf("unsupported bool value: %d", value)
-		}
-		b.Bool = data == 1
-		return nil
-	case int:
-		if data != 0 && data != 1 {
-			return fmt.Errorf("unsupported bool value: %d", value)
-		}
-		b.Bool = data == 1
-		return nil
-	case int8:
-		if data != 0 && data != 1 {
-			return fmt.Errorf("unsupported bool value: %d", value)
-		}
-		b.Bool = data == 1
-		return nil
-	case int16:
-		if data != 0 && data != 1 {
-			return fmt.Errorf("unsupported bool value: %d", value)
-		}
-		b.Bool = data == 1
-		return nil
-	case int32:
-		if data != 0 && data != 1 {
-			return fmt.Errorf("unsupported bool value: %d", value)
-		}
-		b.Bool = data == 1
-		return nil
-	case int64:
-		if data != 0 && data != 1 {
-			return fmt.Errorf("unsupported bool value: %d", value)
-		}
-		b.Bool = data == 1
-		return nil
-	case bool:
-		b.Bool = data
-		return nil
-	default:
-		return fmt.Errorf("unsupported type: %T", value)
+// BoolFromSQL creates a new Bool from a sql.NullBool.
+func BoolFromSQL(b sql.NullBool) Bool {
+	return NewBool(b.Bool, b.Valid)
+}
+
+// Scan implements the Scanner interface.
+func (b *Bool) Scan(value interface{}) error {
+	n := Value[bool]{}
+	if err := n.scan("Bool", value); err != nil {
+		return err
 	}
+	b.Bool, b.Valid = n.V, n.Valid
+	return nil
 }
 
 // Value implements the driver Valuer interface.
 func (b Bool) Value() (driver.Value, error) {
-	if !b.Valid {
-		return nil, nil
-	}
-	return b.Bool, nil
+	return Value[bool]{V: b.Bool, Valid: b.Valid}.Value()
 }
 
 // MarshalJSON encode the value to JSON.
 func (b Bool) MarshalJSON() ([]byte, error) {
-	if !b.Valid {
-		return []byte("null"), nil
-	}
-	return jsonMarshal(b.Bool)
+	return Value[bool]{V: b.Bool, Valid: b.Valid}.MarshalJSON()
 }
 
 // UnmarshalJSON decode data to the value.
+// A quoted value is accepted as well if LenientJSON is true.
 func (b *Bool) UnmarshalJSON(data []byte) error {
-	var bb *bool
-	if err := json.Unmarshal(data, &bb); err != nil {
+	n := Value[bool]{}
+	if err := n.unmarshalJSON("Bool", data); err != nil {
 		return err
 	}
-	b.Valid = bb != nil
-	if b.Valid {
-		b.Bool = *bb
-	} else {
-		b.Bool = false
+	b.Bool, b.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (b Bool) MarshalText() ([]byte, error) {
+	return Value[bool]{V: b.Bool, Valid: b.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (b *Bool) UnmarshalText(text []byte) error {
+	n := Value[bool]{}
+	if err := n.unmarshalText("Bool", text); err != nil {
+		return err
 	}
+	b.Bool, b.Valid = n.V, n.Valid
 	return nil
 }
 
+// MarshalXML implements the xml.Marshaler interface.
+func (b Bool) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, b.Valid, b)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (b *Bool) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, b)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (b Bool) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b.Valid, b)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (b *Bool) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (b Bool) MarshalYAML() (interface{}, error) {
+	return Value[bool]{V: b.Bool, Valid: b.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (b *Bool) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[bool]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
+	}
+	b.Bool, b.Valid = n.V, n.Valid
+	return nil
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (b Bool) LogValue() slog.Value {
+	return Value[bool]{V: b.Bool, Valid: b.Valid}.LogValue()
+}
+
+// Format implements the fmt.Formatter interface.
+func (b Bool) Format(state fmt.State, verb rune) {
+	format(state, verb, b, b.Valid, b.Bool)
+}
+
+// String implements the fmt.Stringer interface.
+func (b Bool) String() string {
+	return fmt.Sprint(b)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (b Bool) Ptr() *bool {
+	return Value[bool]{V: b.Bool, Valid: b.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (b Bool) ValueOrZero() bool {
+	return Value[bool]{V: b.Bool, Valid: b.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (b Bool) ValueOr(v bool) bool {
+	return Value[bool]{V: b.Bool, Valid: b.Valid}.ValueOr(v)
+}
+
+// ToSQL returns the value as a sql.NullBool.
+func (b Bool) ToSQL() sql.NullBool {
+	return sql.NullBool{Bool: b.Bool, Valid: b.Valid}
+}
+
 // IsNull returns true if Valid is false.
 func (b *Bool) IsNull() bool {
 	return !b.Valid
 }
+
+// scanBool converts value, which may be a bool, text accepted by strconv.ParseBool
+// or an integer that is 0 or 1, to a bool.
+func scanBool(value interface{}) (bool, error) {
+	switch data := value.(type) {
+	case bool:
+		return data, nil
+	case string:
+		return strconv.ParseBool(data)
+	case []byte:
+		return strconv.ParseBool(string(data))
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		n, err := scanInt(data, "Bool", 0, 1)
+		return n == 1, err
+	default:
+		return false, ErrUnsupportedType
+	}
+}
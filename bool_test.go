@@ -2,7 +2,9 @@ package null
 
 import (
 	"bytes"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 )
@@ -252,8 +254,8 @@ func TestBoolScanByteParseError(t *testing.T) {
 func TestBoolScanTypeError(t *testing.T) {
 	val := Bool{}
 	err := val.Scan(struct{}{})
-	if err == nil || err.Error() != "unsupported type: struct {}" {
-		t.Fatalf("want %v, but %v:", "unsupported type: struct {}", err)
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
 	}
 }
 
@@ -335,6 +337,63 @@ func TestBoolUnmarshalJSONError(t *testing.T) {
 	}
 }
 
+func TestBoolMarshalText(t *testing.T) {
+	val := NewBool(true, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `true`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestBoolMarshalTextNull(t *testing.T) {
+	val := NewBool(true, false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestBoolUnmarshalText(t *testing.T) {
+	var val Bool
+	if err := val.UnmarshalText([]byte(`true`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewBool(true, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBoolUnmarshalTextEmpty(t *testing.T) {
+	val := NewBool(true, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewBool(false, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBoolUnmarshalTextError(t *testing.T) {
+	val := Bool{}
+	err := val.UnmarshalText([]byte("foo"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
 func TestBoolIsNull(t *testing.T) {
 	val := NewBool(true, true)
 	if val.IsNull() {
@@ -346,3 +405,81 @@ func TestBoolIsNull(t *testing.T) {
 		t.Fatal("it has to be not null")
 	}
 }
+
+func TestBoolFrom(t *testing.T) {
+	val := BoolFrom(true)
+	want := NewBool(true, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBoolFromPtr(t *testing.T) {
+	v := true
+	val := BoolFromPtr(&v)
+	want := NewBool(true, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = BoolFromPtr(nil)
+	want = Bool{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBoolPtr(t *testing.T) {
+	val := NewBool(true, true)
+	got := val.Ptr()
+	if got == nil || *got != true {
+		t.Fatalf("want %v, but %v:", true, got)
+	}
+
+	val = NewBool(true, false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestBoolValueOr(t *testing.T) {
+	val := NewBool(true, true)
+	if got := val.ValueOrZero(); got != true {
+		t.Fatalf("want %v, but %v:", true, got)
+	}
+	if got := val.ValueOr(false); got != true {
+		t.Fatalf("want %v, but %v:", true, got)
+	}
+
+	val = NewBool(true, false)
+	if got := val.ValueOrZero(); got != false {
+		t.Fatalf("want %v, but %v:", false, got)
+	}
+	if got := val.ValueOr(false); got != false {
+		t.Fatalf("want %v, but %v:", false, got)
+	}
+}
+
+func TestBoolFromSQL(t *testing.T) {
+	val := BoolFromSQL(sql.NullBool{Bool: true, Valid: true})
+	want := NewBool(true, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = BoolFromSQL(sql.NullBool{Bool: true, Valid: false})
+	want = NewBool(true, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBoolToSQL(t *testing.T) {
+	for _, valid := range []bool{true, false} {
+		got := NewBool(true, valid).ToSQL()
+		want := sql.NullBool{Bool: true, Valid: valid}
+		if got != want {
+			t.Fatalf("want %v, but %v:", want, got)
+		}
+	}
+}
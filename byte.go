@@ -1,9 +1,11 @@
 package null
 
 import (
+	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"log/slog"
 )
 
 // Byte represents a byte that may be null.
@@ -17,54 +19,148 @@ func NewByte(b byte, valid bool) Byte {
 	return Byte{Byte: b, Valid: valid}
 }
 
-// Scan implements the Scanner interface.
-func (b *Byte) Scan(value interface{}) error {
-	if value == nil {
-		b.Byte, b.Valid = byte(0), false
-		return nil
+// ByteFrom creates a new Byte that is always valid.
+func ByteFrom(b byte) Byte {
+	return NewByte(b, true)
+}
+
+// ByteFromPtr creates a new Byte that is null if b is nil.
+func ByteFromPtr(b *byte) Byte {
+	if b == nil {
+		return Byte{}
 	}
+	return NewByte(*b, true)
+}
+
+// ByteFromSQL creates a new Byte from a sql.NullByte.
+func ByteFromSQL(b sql.NullByte) Byte {
+	return NewByte(b.Byte, b.Valid)
+}
 
-	b.Valid = true
-	switch data := value.(type) {
-	case byte:
-		b.Byte = data
-		return nil
-	default:
-		return fmt.Errorf("unsupported type: %T", value)
+// Scan implements the Scanner interface.
+// Numbers of any integer type and floats with no fractional part are accepted,
+// and a number out of the range of Byte is reported as an *OverflowError.
+func (b *Byte) Scan(value interface{}) error {
+	n := Value[byte]{}
+	if err := n.scan("Byte", value); err != nil {
+		return err
 	}
+	b.Byte, b.Valid = n.V, n.Valid
+	return nil
 }
 
 // Value implements the driver Valuer interface.
 func (b Byte) Value() (driver.Value, error) {
-	if !b.Valid {
-		return nil, nil
-	}
-	return int64(b.Byte), nil
+	return Value[byte]{V: b.Byte, Valid: b.Valid}.Value()
 }
 
 // MarshalJSON encode the value to JSON.
 func (b Byte) MarshalJSON() ([]byte, error) {
-	if !b.Valid {
-		return []byte("null"), nil
-	}
-	return jsonMarshal(b.Byte)
+	return Value[byte]{V: b.Byte, Valid: b.Valid}.MarshalJSON()
 }
 
 // UnmarshalJSON decode data to the value.
+// A quoted value is accepted as well if LenientJSON is true.
 func (b *Byte) UnmarshalJSON(data []byte) error {
-	var bb *byte
-	if err := json.Unmarshal(data, &bb); err != nil {
+	n := Value[byte]{}
+	if err := n.unmarshalJSON("Byte", data); err != nil {
+		return err
+	}
+	b.Byte, b.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (b Byte) MarshalText() ([]byte, error) {
+	return Value[byte]{V: b.Byte, Valid: b.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (b *Byte) UnmarshalText(text []byte) error {
+	n := Value[byte]{}
+	if err := n.unmarshalText("Byte", text); err != nil {
 		return err
 	}
-	b.Valid = bb != nil
-	if b.Valid {
-		b.Byte = *bb
-	} else {
-		b.Byte = byte(0)
+	b.Byte, b.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (b Byte) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, b.Valid, b)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (b *Byte) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, b)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (b Byte) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b.Valid, b)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (b *Byte) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (b Byte) MarshalYAML() (interface{}, error) {
+	return Value[byte]{V: b.Byte, Valid: b.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (b *Byte) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[byte]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
 	}
+	b.Byte, b.Valid = n.V, n.Valid
 	return nil
 }
 
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (b Byte) LogValue() slog.Value {
+	return Value[byte]{V: b.Byte, Valid: b.Valid}.LogValue()
+}
+
+// Format implements the fmt.Formatter interface.
+func (b Byte) Format(state fmt.State, verb rune) {
+	format(state, verb, b, b.Valid, b.Byte)
+}
+
+// String implements the fmt.Stringer interface.
+func (b Byte) String() string {
+	return fmt.Sprint(b)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (b Byte) Ptr() *byte {
+	return Value[byte]{V: b.Byte, Valid: b.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (b Byte) ValueOrZero() byte {
+	return Value[byte]{V: b.Byte, Valid: b.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (b Byte) ValueOr(v byte) byte {
+	return Value[byte]{V: b.Byte, Valid: b.Valid}.ValueOr(v)
+}
+
+// ToSQL returns the value as a sql.NullByte.
+func (b Byte) ToSQL() sql.NullByte {
+	return sql.NullByte{Byte: b.Byte, Valid: b.Valid}
+}
+
 // IsNull returns true if Valid is false.
 func (b *Byte) IsNull() bool {
 	return !b.Valid
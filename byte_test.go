@@ -2,7 +2,9 @@ package null
 
 import (
 	"bytes"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 )
@@ -31,11 +33,43 @@ func TestByteScanByte(t *testing.T) {
 	}
 }
 
+func TestByteScanString(t *testing.T) {
+	val := Byte{}
+	if err := val.Scan("1"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewByte(byte(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestByteScanBytes(t *testing.T) {
+	val := Byte{}
+	if err := val.Scan([]byte("7")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewByte(byte(7), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestByteScanStringOutOfRange(t *testing.T) {
+	val := Byte{}
+	err := val.Scan("256")
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
+	}
+}
+
 func TestByteScanError(t *testing.T) {
 	val := Byte{}
 	err := val.Scan(struct{}{})
-	if err == nil || err.Error() != "unsupported type: struct {}" {
-		t.Fatalf("want %v, but %v:", "unsupported type: struct {}", err)
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
 	}
 }
 
@@ -117,6 +151,63 @@ func TestByteUnmarshalJSONError(t *testing.T) {
 	}
 }
 
+func TestByteMarshalText(t *testing.T) {
+	val := NewByte(1, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `1`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestByteMarshalTextNull(t *testing.T) {
+	val := NewByte(1, false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestByteUnmarshalText(t *testing.T) {
+	var val Byte
+	if err := val.UnmarshalText([]byte(`1`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewByte(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestByteUnmarshalTextEmpty(t *testing.T) {
+	val := NewByte(1, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewByte(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestByteUnmarshalTextError(t *testing.T) {
+	val := Byte{}
+	err := val.UnmarshalText([]byte("256"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
 func TestByteIsNull(t *testing.T) {
 	val := NewByte(byte(97), true)
 	if val.IsNull() {
@@ -128,3 +219,81 @@ func TestByteIsNull(t *testing.T) {
 		t.Fatal("it has to be not null")
 	}
 }
+
+func TestByteFrom(t *testing.T) {
+	val := ByteFrom(byte(1))
+	want := NewByte(byte(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestByteFromPtr(t *testing.T) {
+	v := byte(1)
+	val := ByteFromPtr(&v)
+	want := NewByte(byte(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = ByteFromPtr(nil)
+	want = Byte{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBytePtr(t *testing.T) {
+	val := NewByte(byte(1), true)
+	got := val.Ptr()
+	if got == nil || *got != byte(1) {
+		t.Fatalf("want %v, but %v:", byte(1), got)
+	}
+
+	val = NewByte(byte(1), false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestByteValueOr(t *testing.T) {
+	val := NewByte(byte(1), true)
+	if got := val.ValueOrZero(); got != byte(1) {
+		t.Fatalf("want %v, but %v:", byte(1), got)
+	}
+	if got := val.ValueOr(byte(2)); got != byte(1) {
+		t.Fatalf("want %v, but %v:", byte(1), got)
+	}
+
+	val = NewByte(byte(1), false)
+	if got := val.ValueOrZero(); got != byte(0) {
+		t.Fatalf("want %v, but %v:", byte(0), got)
+	}
+	if got := val.ValueOr(byte(2)); got != byte(2) {
+		t.Fatalf("want %v, but %v:", byte(2), got)
+	}
+}
+
+func TestByteFromSQL(t *testing.T) {
+	val := ByteFromSQL(sql.NullByte{Byte: 1, Valid: true})
+	want := NewByte(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = ByteFromSQL(sql.NullByte{Byte: 1, Valid: false})
+	want = NewByte(1, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestByteToSQL(t *testing.T) {
+	for _, valid := range []bool{true, false} {
+		got := NewByte(1, valid).ToSQL()
+		want := sql.NullByte{Byte: 1, Valid: valid}
+		if got != want {
+			t.Fatalf("want %v, but %v:", want, got)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+)
+
+// Bytes represents a []byte that may be null.
+type Bytes struct {
+	Bytes []byte
+	Valid bool
+}
+
+// NewBytes creates a new Bytes
+func NewBytes(b []byte, valid bool) Bytes {
+	return Bytes{Bytes: b, Valid: valid}
+}
+
+// BytesFrom creates a new Bytes that is always valid.
+func BytesFrom(b []byte) Bytes {
+	return NewBytes(b, true)
+}
+
+// BytesFromPtr creates a new Bytes that is null if b is nil.
+func BytesFromPtr(b *[]byte) Bytes {
+	if b == nil {
+		return Bytes{}
+	}
+	return NewBytes(*b, true)
+}
+
+// Scan implements the Scanner interface.
+// The data is copied, since drivers may reuse the buffer passed to Scan.
+func (b *Bytes) Scan(value interface{}) error {
+	if value == nil {
+		b.Bytes, b.Valid = nil, false
+		return nil
+	}
+
+	switch data := value.(type) {
+	case []byte:
+		b.Bytes, b.Valid = append([]byte{}, data...), true
+		return nil
+	case string:
+		b.Bytes, b.Valid = []byte(data), true
+		return nil
+	default:
+		return newScanError("Bytes", value, ErrUnsupportedType)
+	}
+}
+
+// Value implements the driver Valuer interface.
+func (b Bytes) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return b.Bytes, nil
+}
+
+// MarshalJSON encode the value to JSON.
+// The value is a base64 string, as encoding/json encodes []byte. Use BytesHex for a hex string.
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	return marshalJSONBytes(b.Bytes), nil
+}
+
+// UnmarshalJSON decode data to the value.
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	var bs *[]byte
+	if err := json.Unmarshal(data, &bs); err != nil {
+		return err
+	}
+	b.Valid = bs != nil
+	if b.Valid {
+		b.Bytes = *bs
+	} else {
+		b.Bytes = nil
+	}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The value is encoded as base64, so that XML and other text formats can hold any bytes.
+// Null is encoded as empty text.
+func (b Bytes) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte{}, nil
+	}
+	text := make([]byte, base64.StdEncoding.EncodedLen(len(b.Bytes)))
+	base64.StdEncoding.Encode(text, b.Bytes)
+	return text, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The text is decoded as base64. Empty text is decoded as null.
+func (b *Bytes) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		b.Bytes, b.Valid = nil, false
+		return nil
+	}
+	bs := make([]byte, base64.StdEncoding.DecodedLen(len(text)))
+	n, err := base64.StdEncoding.Decode(bs, text)
+	if err != nil {
+		return err
+	}
+	b.Bytes, b.Valid = bs[:n], true
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (b Bytes) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, b.Valid, b)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (b *Bytes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, b)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (b Bytes) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b.Valid, b)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (b *Bytes) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+// The value is encoded as a base64 string.
+func (b Bytes) MarshalYAML() (interface{}, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return base64.StdEncoding.EncodeToString(b.Bytes), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (b *Bytes) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str *string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if str == nil {
+		b.Bytes, b.Valid = nil, false
+		return nil
+	}
+	bs, err := base64.StdEncoding.DecodeString(*str)
+	if err != nil {
+		return err
+	}
+	b.Bytes, b.Valid = bs, true
+	return nil
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (b Bytes) LogValue() slog.Value {
+	if !b.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(b.Bytes)
+}
+
+// Format implements the fmt.Formatter interface.
+func (b Bytes) Format(state fmt.State, verb rune) {
+	format(state, verb, b, b.Valid, b.Bytes)
+}
+
+// String implements the fmt.Stringer interface.
+func (b Bytes) String() string {
+	return fmt.Sprint(b)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (b Bytes) Ptr() *[]byte {
+	if !b.Valid {
+		return nil
+	}
+	v := b.Bytes
+	return &v
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (b Bytes) ValueOrZero() []byte {
+	if !b.Valid {
+		var zero []byte
+		return zero
+	}
+	return b.Bytes
+}
+
+// ValueOr returns the value, or v if it is null.
+func (b Bytes) ValueOr(v []byte) []byte {
+	if !b.Valid {
+		return v
+	}
+	return b.Bytes
+}
+
+// IsNull returns true if Valid is false.
+func (b *Bytes) IsNull() bool {
+	return !b.Valid
+}
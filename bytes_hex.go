@@ -0,0 +1,67 @@
+package null
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// BytesHex represents a []byte that may be null and is encoded in JSON as a hex string
+// with a "\x" prefix, as PostgreSQL outputs bytea, instead of a base64 string.
+// It behaves like Bytes in every other respect.
+type BytesHex struct {
+	Bytes
+}
+
+// NewBytesHex creates a new BytesHex
+func NewBytesHex(b []byte, valid bool) BytesHex {
+	return BytesHex{Bytes: NewBytes(b, valid)}
+}
+
+// BytesHexFrom creates a new BytesHex that is always valid.
+func BytesHexFrom(b []byte) BytesHex {
+	return NewBytesHex(b, true)
+}
+
+// BytesHexFromPtr creates a new BytesHex that is null if b is nil.
+func BytesHexFromPtr(b *[]byte) BytesHex {
+	return BytesHex{Bytes: BytesFromPtr(b)}
+}
+
+// MarshalJSON encode the value to JSON.
+func (b BytesHex) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	return marshalJSONHex(b.Bytes.Bytes), nil
+}
+
+// UnmarshalJSON decode data to the value.
+// The "\x" prefix is optional.
+func (b *BytesHex) UnmarshalJSON(data []byte) error {
+	var str *string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	if str == nil {
+		b.Bytes.Bytes, b.Valid = nil, false
+		return nil
+	}
+	bs, err := hex.DecodeString(strings.TrimPrefix(*str, `\x`))
+	if err != nil {
+		return err
+	}
+	b.Bytes.Bytes, b.Valid = bs, true
+	return nil
+}
+
+// Format implements the fmt.Formatter interface.
+func (b BytesHex) Format(state fmt.State, verb rune) {
+	format(state, verb, b, b.Valid, b.Bytes.Bytes)
+}
+
+// String implements the fmt.Stringer interface.
+func (b BytesHex) String() string {
+	return fmt.Sprint(b)
+}
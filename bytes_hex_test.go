@@ -0,0 +1,89 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBytesHexMarshalJSON(t *testing.T) {
+	val := struct {
+		Hash BytesHex `json:"hash"`
+		Data Bytes    `json:"data"`
+		Salt BytesHex `json:"salt"`
+	}{NewBytesHex([]byte{0xde, 0xad, 0xbe, 0xef}, true), NewBytes([]byte{0xde, 0xad, 0xbe, 0xef}, true), BytesHex{}}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"hash":"\\xdeadbeef","data":"3q2+7w==","salt":null}`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestBytesHexUnmarshalJSON(t *testing.T) {
+	for _, data := range []string{`"\\xdeadbeef"`, `"deadbeef"`} {
+		var val BytesHex
+		if err := json.Unmarshal([]byte(data), &val); err != nil {
+			t.Fatal(err)
+		}
+		if want := NewBytesHex([]byte{0xde, 0xad, 0xbe, 0xef}, true); !reflect.DeepEqual(val, want) {
+			t.Fatalf("want %v, but %v:", want, val)
+		}
+	}
+}
+
+func TestBytesHexUnmarshalJSONNull(t *testing.T) {
+	val := NewBytesHex([]byte("foo"), true)
+	if err := json.Unmarshal([]byte("null"), &val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewBytesHex(nil, false)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBytesHexUnmarshalJSONError(t *testing.T) {
+	val := BytesHex{}
+	if err := val.UnmarshalJSON([]byte(`"\\xzz"`)); err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestBytesHexValue(t *testing.T) {
+	got, err := NewBytesHex([]byte("foo"), true).Value()
+	if !reflect.DeepEqual(got, []byte("foo")) || err != nil {
+		t.Fatalf("want %v, but %v:", []byte("foo"), got)
+	}
+}
+
+func TestBytesHexFormat(t *testing.T) {
+	val := NewBytesHex([]byte("foo"), true)
+	if got := fmt.Sprint(val); got != "[102 111 111]" {
+		t.Fatalf("want %v, but %v:", "[102 111 111]", got)
+	}
+
+	want := "null.BytesHex{Bytes:null.Bytes{Bytes:[]byte{0x66, 0x6f, 0x6f}, Valid:true}}"
+	if got := fmt.Sprintf("%#v", val); got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestBytesHexFromPtr(t *testing.T) {
+	if got := BytesHexFromPtr(nil); !reflect.DeepEqual(got, BytesHex{}) {
+		t.Fatalf("want %v, but %v:", BytesHex{}, got)
+	}
+
+	b := []byte("foo")
+	if got, want := BytesHexFromPtr(&b), BytesHexFrom([]byte("foo")); !reflect.DeepEqual(got, want) {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
@@ -0,0 +1,281 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBytesScanNull(t *testing.T) {
+	val := NewBytes([]byte("foo"), true)
+	if err := val.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewBytes(nil, false)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBytesScanByte(t *testing.T) {
+	val := Bytes{}
+	data := []byte{0x00, 0xff}
+	if err := val.Scan(data); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewBytes([]byte{0x00, 0xff}, true)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBytesScanByteCopy(t *testing.T) {
+	val := Bytes{}
+	data := []byte("foo")
+	if err := val.Scan(data); err != nil {
+		t.Fatal(err)
+	}
+	copy(data, "bar")
+
+	want := NewBytes([]byte("foo"), true)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBytesScanByteEmpty(t *testing.T) {
+	val := Bytes{}
+	if err := val.Scan([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewBytes([]byte{}, true)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBytesScanString(t *testing.T) {
+	val := Bytes{}
+	if err := val.Scan("foo"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewBytes([]byte("foo"), true)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBytesScanTypeError(t *testing.T) {
+	val := Bytes{}
+	err := val.Scan(struct{}{})
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+}
+
+func TestBytesValueBytes(t *testing.T) {
+	val := NewBytes([]byte("foo"), true)
+	got, err := val.Value()
+	if !reflect.DeepEqual(got, []byte("foo")) || err != nil {
+		t.Fatalf("want %v, but %v:", []byte("foo"), got)
+	}
+}
+
+func TestBytesValueNull(t *testing.T) {
+	val := NewBytes([]byte("foo"), false)
+	got, err := val.Value()
+	if got != nil || err != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestBytesMarshalJSONBase64(t *testing.T) {
+	val := NewBytes([]byte{0xde, 0xad, 0xbe, 0xef}, true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `"3q2+7w=="`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestBytesMarshalJSONNull(t *testing.T) {
+	val := NewBytes([]byte("foo"), false)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "null"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestBytesUnmarshalJSONBase64(t *testing.T) {
+	var val Bytes
+	err := json.NewDecoder(strings.NewReader(`"3q2+7w=="`)).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewBytes([]byte{0xde, 0xad, 0xbe, 0xef}, true)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBytesUnmarshalJSONNull(t *testing.T) {
+	val := NewBytes([]byte("foo"), true)
+	err := json.NewDecoder(strings.NewReader("null")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewBytes(nil, false)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBytesUnmarshalJSONError(t *testing.T) {
+	val := Bytes{}
+	err := val.UnmarshalJSON([]byte(`"!!"`))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestBytesMarshalText(t *testing.T) {
+	val := NewBytes([]byte("foo"), true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `Zm9v`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestBytesMarshalTextNull(t *testing.T) {
+	val := NewBytes([]byte("foo"), false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestBytesUnmarshalText(t *testing.T) {
+	var val Bytes
+	if err := val.UnmarshalText([]byte(`Zm9v`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewBytes([]byte("foo"), true)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBytesUnmarshalTextError(t *testing.T) {
+	val := Bytes{}
+	if err := val.UnmarshalText([]byte(`!!`)); err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestBytesUnmarshalTextEmpty(t *testing.T) {
+	val := NewBytes([]byte("foo"), true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewBytes(nil, false)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBytesIsNull(t *testing.T) {
+	val := NewBytes(nil, true)
+	if val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+
+	val = NewBytes(nil, false)
+	if !val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+}
+
+func TestBytesFrom(t *testing.T) {
+	val := BytesFrom([]byte("foo"))
+	want := NewBytes([]byte("foo"), true)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBytesFromPtr(t *testing.T) {
+	v := []byte("foo")
+	val := BytesFromPtr(&v)
+	want := NewBytes([]byte("foo"), true)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = BytesFromPtr(nil)
+	want = Bytes{}
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestBytesPtr(t *testing.T) {
+	val := NewBytes([]byte("foo"), true)
+	got := val.Ptr()
+	if got == nil || !reflect.DeepEqual(*got, []byte("foo")) {
+		t.Fatalf("want %v, but %v:", []byte("foo"), got)
+	}
+
+	val = NewBytes([]byte("foo"), false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestBytesValueOr(t *testing.T) {
+	val := NewBytes([]byte("foo"), true)
+	if got := val.ValueOrZero(); !reflect.DeepEqual(got, []byte("foo")) {
+		t.Fatalf("want %v, but %v:", []byte("foo"), got)
+	}
+	if got := val.ValueOr([]byte("bar")); !reflect.DeepEqual(got, []byte("foo")) {
+		t.Fatalf("want %v, but %v:", []byte("foo"), got)
+	}
+
+	val = NewBytes([]byte("foo"), false)
+	if got := val.ValueOrZero(); !reflect.DeepEqual(got, []byte(nil)) {
+		t.Fatalf("want %v, but %v:", []byte(nil), got)
+	}
+	if got := val.ValueOr([]byte("bar")); !reflect.DeepEqual(got, []byte("bar")) {
+		t.Fatalf("want %v, but %v:", []byte("bar"), got)
+	}
+}
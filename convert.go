@@ -0,0 +1,188 @@
+package null
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// OverflowError is returned by Scan when a number is out of the range of the integer type it is scanned into.
+type OverflowError struct {
+	// Target is the name of the type the number was scanned into, e.g. "Int8".
+	Target string
+	// Value is the number that was out of range.
+	Value interface{}
+}
+
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("maximum or minimum value of %s exceeded: %v", e.Target, e.Value)
+}
+
+// Unwrap returns ErrOutOfRange, so that errors.Is(err, ErrOutOfRange) reports an OverflowError.
+func (e *OverflowError) Unwrap() error {
+	return ErrOutOfRange
+}
+
+// scanInt converts value, which may be of any integer kind or a float with no fractional part,
+// to an int64 in the range [min, max] of the target type.
+func scanInt(value interface{}, target string, min, max int64) (int64, error) {
+	var n int64
+	switch data := value.(type) {
+	case int:
+		n = int64(data)
+	case int8:
+		n = int64(data)
+	case int16:
+		n = int64(data)
+	case int32:
+		n = int64(data)
+	case int64:
+		n = data
+	case uint, uint8, uint16, uint32, uint64:
+		u := toUint64(data)
+		if u > uint64(max) {
+			return 0, &OverflowError{Target: target, Value: value}
+		}
+		return int64(u), nil
+	case float32:
+		return floatToInt(float64(data), value, target, min, max)
+	case float64:
+		return floatToInt(data, value, target, min, max)
+	default:
+		return 0, ErrUnsupportedType
+	}
+	if n < min || n > max {
+		return 0, &OverflowError{Target: target, Value: value}
+	}
+	return n, nil
+}
+
+// scanUint converts value, which may be of any integer kind or a float with no fractional part,
+// to a uint64 in the range [0, max] of the target type.
+func scanUint(value interface{}, target string, max uint64) (uint64, error) {
+	var u uint64
+	switch data := value.(type) {
+	case int, int8, int16, int32, int64:
+		n, err := scanInt(data, target, 0, math.MaxInt64)
+		if err != nil {
+			return 0, err
+		}
+		u = uint64(n)
+	case uint, uint8, uint16, uint32, uint64:
+		u = toUint64(data)
+	case float32:
+		return floatToUint(float64(data), value, target, max)
+	case float64:
+		return floatToUint(data, value, target, max)
+	default:
+		return 0, ErrUnsupportedType
+	}
+	if u > max {
+		return 0, &OverflowError{Target: target, Value: value}
+	}
+	return u, nil
+}
+
+func toUint64(value interface{}) uint64 {
+	switch data := value.(type) {
+	case uint:
+		return uint64(data)
+	case uint8:
+		return uint64(data)
+	case uint16:
+		return uint64(data)
+	case uint32:
+		return uint64(data)
+	default:
+		return value.(uint64)
+	}
+}
+
+func floatToInt(f float64, value interface{}, target string, min, max int64) (int64, error) {
+	if f != math.Trunc(f) {
+		return 0, fmt.Errorf("%w for %s: %v", ErrFractional, target, value)
+	}
+	// float64(max) may round up to the next power of two, which is out of range itself.
+	if f < float64(min) || f >= float64(max)+1 {
+		return 0, &OverflowError{Target: target, Value: value}
+	}
+	return int64(f), nil
+}
+
+func floatToUint(f float64, value interface{}, target string, max uint64) (uint64, error) {
+	if f != math.Trunc(f) {
+		return 0, fmt.Errorf("%w for %s: %v", ErrFractional, target, value)
+	}
+	if f < 0 || f >= float64(max)+1 {
+		return 0, &OverflowError{Target: target, Value: value}
+	}
+	return uint64(f), nil
+}
+
+// scanSigned converts value, which may be decimal text, a number of any integer kind or a float
+// with no fractional part, to the signed integer type N of the given bits named target.
+func scanSigned[N int | int8 | int16 | int32 | int64](value interface{}, target string, bits int) (N, error) {
+	var n int64
+	var err error
+	switch data := value.(type) {
+	case string:
+		n, err = strconv.ParseInt(data, 10, bits)
+	case []byte:
+		n, err = strconv.ParseInt(string(data), 10, bits)
+	default:
+		n, err = scanInt(value, target, int64(-1)<<(bits-1), int64(1)<<(bits-1)-1)
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		err = &OverflowError{Target: target, Value: value}
+	}
+	return N(n), err
+}
+
+// scanUnsigned converts value, which may be decimal text, a number of any integer kind or a float
+// with no fractional part, to the unsigned integer type N of the given bits named target.
+func scanUnsigned[N uint | uint8 | uint16 | uint32 | uint64](value interface{}, target string, bits int) (N, error) {
+	var u uint64
+	var err error
+	switch data := value.(type) {
+	case string:
+		u, err = strconv.ParseUint(data, 10, bits)
+	case []byte:
+		u, err = strconv.ParseUint(string(data), 10, bits)
+	default:
+		u, err = scanUint(value, target, math.MaxUint64>>(64-bits))
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		err = &OverflowError{Target: target, Value: value}
+	}
+	return N(u), err
+}
+
+// scanFloat converts value, which may be decimal text, a number of any signed integer kind
+// or a float of type F, to F. A float32 is accepted by float64 as well, since it converts exactly.
+func scanFloat[F float32 | float64](value interface{}, bits int) (F, error) {
+	switch data := value.(type) {
+	case string:
+		f, err := strconv.ParseFloat(data, bits)
+		return F(f), err
+	case []byte:
+		f, err := strconv.ParseFloat(string(data), bits)
+		return F(f), err
+	case int:
+		return F(data), nil
+	case int8:
+		return F(data), nil
+	case int16:
+		return F(data), nil
+	case int32:
+		return F(data), nil
+	case int64:
+		return F(data), nil
+	case F:
+		return data, nil
+	case float32:
+		return F(data), nil
+	default:
+		return 0, ErrUnsupportedType
+	}
+}
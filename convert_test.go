@@ -0,0 +1,105 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"math"
+	"testing"
+)
+
+type testIntScanner interface {
+	sql.Scanner
+	driver.Valuer
+}
+
+func TestScanIntConversion(t *testing.T) {
+	tests := []struct {
+		val  testIntScanner
+		in   interface{}
+		want int64
+	}{
+		{&Int{}, uint64(math.MaxInt32), math.MaxInt32},
+		{&Int{}, float64(-3), -3},
+		{&Int8{}, int64(-128), -128},
+		{&Int8{}, int32(127), 127},
+		{&Int8{}, uint16(1), 1},
+		{&Int8{}, float32(-1), -1},
+		{&Int16{}, int64(math.MaxInt16), math.MaxInt16},
+		{&Int16{}, uint(2), 2},
+		{&Int32{}, int64(math.MinInt32), math.MinInt32},
+		{&Int32{}, float64(1e9), 1e9},
+		{&Int64{}, uint64(math.MaxInt64), math.MaxInt64},
+		{&Int64{}, float64(-1 << 63), math.MinInt64},
+		{&Uint{}, int64(1), 1},
+		{&Uint8{}, int64(255), 255},
+		{&Uint8{}, float64(255), 255},
+		{&Uint16{}, int32(math.MaxUint16), math.MaxUint16},
+		{&Uint32{}, uint64(math.MaxUint32), math.MaxUint32},
+		{&Uint64{}, int8(1), 1},
+		{&Byte{}, int64(255), 255},
+		{&Byte{}, uint32(1), 1},
+		{&Byte{}, float64(2), 2},
+	}
+	for _, tt := range tests {
+		if err := tt.val.Scan(tt.in); err != nil {
+			t.Fatalf("%T %T: %v", tt.val, tt.in, err)
+		}
+		got, err := tt.val.Value()
+		if got != tt.want || err != nil {
+			t.Fatalf("%T %T: want %v, but %v:", tt.val, tt.in, tt.want, got)
+		}
+	}
+}
+
+func TestScanIntOverflow(t *testing.T) {
+	tests := []struct {
+		val    sql.Scanner
+		in     interface{}
+		target string
+	}{
+		{&Int8{}, int64(128), "Int8"},
+		{&Int8{}, int64(-129), "Int8"},
+		{&Int8{}, uint64(math.MaxUint64), "Int8"},
+		{&Int8{}, float64(128), "Int8"},
+		{&Int16{}, int32(math.MaxInt16 + 1), "Int16"},
+		{&Int32{}, float64(math.MinInt32 - 1), "Int32"},
+		{&Int64{}, uint64(math.MaxInt64 + 1), "Int64"},
+		{&Int64{}, float64(1 << 63), "Int64"},
+		{&Int64{}, math.Inf(-1), "Int64"},
+		{&Uint{}, int64(-1), "Uint"},
+		{&Uint8{}, int(256), "Uint8"},
+		{&Uint16{}, float32(-1), "Uint16"},
+		{&Uint32{}, uint64(math.MaxUint32 + 1), "Uint32"},
+		{&Uint64{}, float64(1 << 64), "Uint64"},
+		{&Uint64{}, int8(-1), "Uint64"},
+		{&Byte{}, int64(256), "Byte"},
+	}
+	for _, tt := range tests {
+		err := tt.val.Scan(tt.in)
+		var overflow *OverflowError
+		if !errors.As(err, &overflow) {
+			t.Fatalf("%T %T: want an *OverflowError, but %v:", tt.val, tt.in, err)
+		}
+		if overflow.Target != tt.target || overflow.Value != tt.in {
+			t.Fatalf("want %v %v, but %v %v:", tt.target, tt.in, overflow.Target, overflow.Value)
+		}
+	}
+}
+
+func TestScanIntFractional(t *testing.T) {
+	for _, val := range []sql.Scanner{&Int{}, &Int8{}, &Int64{}, &Uint{}, &Uint64{}, &Byte{}, &Value[int8]{}} {
+		err := val.Scan(1.5)
+		if !errors.Is(err, ErrFractional) || errors.Is(err, ErrOutOfRange) {
+			t.Fatalf("%T: want %v, but %v:", val, ErrFractional, err)
+		}
+	}
+}
+
+func TestOverflowErrorError(t *testing.T) {
+	err := &OverflowError{Target: "Int8", Value: int64(128)}
+	want := "maximum or minimum value of Int8 exceeded: 128"
+	if err.Error() != want {
+		t.Fatalf("want %v, but %v:", want, err.Error())
+	}
+}
@@ -0,0 +1,270 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// Date represents a calendar date that may be null, such as a DATE column.
+// It has no time zone, so a date is never shifted by a time zone conversion,
+// and two Dates can be compared with ==.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+	Valid bool
+}
+
+// NewDate creates a new Date
+func NewDate(year int, month time.Month, day int, valid bool) Date {
+	return Date{Year: year, Month: month, Day: day, Valid: valid}
+}
+
+// DateOf returns the date of t in the location of t.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day, Valid: true}
+}
+
+// DateFrom creates a new Date that is always valid, from the date of t in the location of t.
+func DateFrom(t time.Time) Date {
+	return DateOf(t)
+}
+
+// DateFromPtr returns the date of *t in the location of *t, or null if t is nil.
+func DateFromPtr(t *time.Time) Date {
+	if t == nil {
+		return Date{}
+	}
+	return DateOf(*t)
+}
+
+// Scan implements the Scanner interface.
+// A time.Time is read in its own location. Text is read in the form "2006-01-02",
+// optionally followed by a time of day, which is ignored.
+func (d *Date) Scan(value interface{}) error {
+	if value == nil {
+		*d = Date{}
+		return nil
+	}
+
+	switch data := value.(type) {
+	case time.Time:
+		*d = DateOf(data)
+		return nil
+	case string:
+		date, err := parseDate(data)
+		if err != nil {
+			return newScanError("Date", value, err)
+		}
+		*d = date
+		return nil
+	case []byte:
+		date, err := parseDate(string(data))
+		if err != nil {
+			return newScanError("Date", value, err)
+		}
+		*d = date
+		return nil
+	default:
+		return newScanError("Date", value, ErrUnsupportedType)
+	}
+}
+
+// Value implements the driver Valuer interface.
+// The value is a string in the form "2006-01-02", which avoids the time zone
+// conversion some drivers apply to a time.Time.
+func (d Date) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.format(), nil
+}
+
+// MarshalJSON encode the value to JSON.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	b := make([]byte, 0, len(dateLayout)+2)
+	b = append(b, '"')
+	b = d.appendFormat(b)
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON decode data to the value.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var str *string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	if str == nil {
+		*d = Date{}
+		return nil
+	}
+	return d.Scan(*str)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (d Date) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return []byte(d.format()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (d *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = Date{}
+		return nil
+	}
+	return d.Scan(string(text))
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (d Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, d.Valid, d)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (d *Date) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, d)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (d Date) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, d.Valid, d)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (d *Date) UnmarshalXMLAttr(attr xml.Attr) error {
+	return d.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (d Date) MarshalYAML() (interface{}, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.format(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (d *Date) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str *string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if str == nil {
+		*d = Date{}
+		return nil
+	}
+	return d.Scan(*str)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (d Date) LogValue() slog.Value {
+	if !d.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(d.format())
+}
+
+// Format implements the fmt.Formatter interface.
+func (d Date) Format(state fmt.State, verb rune) {
+	format(state, verb, d, d.Valid, d.format())
+}
+
+// String implements the fmt.Stringer interface.
+func (d Date) String() string {
+	return fmt.Sprint(d)
+}
+
+// Ptr returns a pointer to the time.Time at midnight of the date in UTC, or nil if it is null.
+func (d Date) Ptr() *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	t := d.In(time.UTC)
+	return &t
+}
+
+// ValueOrZero returns the time.Time at midnight of the date in UTC, or the zero time.Time if it is null.
+func (d Date) ValueOrZero() time.Time {
+	if !d.Valid {
+		return time.Time{}
+	}
+	return d.In(time.UTC)
+}
+
+// ValueOr returns the time.Time at midnight of the date in UTC, or v if it is null.
+func (d Date) ValueOr(v time.Time) time.Time {
+	if !d.Valid {
+		return v
+	}
+	return d.In(time.UTC)
+}
+
+// In returns the time.Time at midnight of the date in loc.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// Before reports whether d is before d2.
+func (d Date) Before(d2 Date) bool {
+	if d.Year != d2.Year {
+		return d.Year < d2.Year
+	}
+	if d.Month != d2.Month {
+		return d.Month < d2.Month
+	}
+	return d.Day < d2.Day
+}
+
+// After reports whether d is after d2.
+func (d Date) After(d2 Date) bool {
+	return d2.Before(d)
+}
+
+// IsNull returns true if Valid is false.
+func (d *Date) IsNull() bool {
+	return !d.Valid
+}
+
+func (d Date) format() string {
+	return string(d.appendFormat(make([]byte, 0, len(dateLayout))))
+}
+
+// appendFormat appends the date in the form "2006-01-02" to dst.
+func (d Date) appendFormat(dst []byte) []byte {
+	dst = appendPadded(dst, d.Year, 4)
+	dst = append(dst, '-')
+	dst = appendPadded(dst, int(d.Month), 2)
+	dst = append(dst, '-')
+	return appendPadded(dst, d.Day, 2)
+}
+
+func parseDate(s string) (Date, error) {
+	text := s
+	if len(text) > len(dateLayout) && (text[len(dateLayout)] == ' ' || text[len(dateLayout)] == 'T') {
+		text = text[:len(dateLayout)]
+	}
+	t, err := time.Parse(dateLayout, text)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date: %q", s)
+	}
+	return DateOf(t), nil
+}
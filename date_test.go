@@ -0,0 +1,277 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDateScanNull(t *testing.T) {
+	val := NewDate(2022, time.January, 2, true)
+	if err := val.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDate(0, 0, 0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDateScanTime(t *testing.T) {
+	val := Date{}
+	tokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+	if err := val.Scan(time.Date(2022, 1, 2, 0, 30, 0, 0, tokyo)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDate(2022, time.January, 2, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDateScanString(t *testing.T) {
+	for _, in := range []string{"2022-01-02", "2022-01-02 23:59:59", "2022-01-02T23:59:59-10:00"} {
+		val := Date{}
+		if err := val.Scan(in); err != nil {
+			t.Fatal(err)
+		}
+
+		want := NewDate(2022, time.January, 2, true)
+		if val != want {
+			t.Fatalf("want %v, but %v:", want, val)
+		}
+	}
+}
+
+func TestDateScanByte(t *testing.T) {
+	val := Date{}
+	if err := val.Scan([]byte("2020-02-29")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDate(2020, time.February, 29, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDateScanStringParseError(t *testing.T) {
+	for _, in := range []string{"", "foo", "2021-02-29", "2022-1-2", "2022-01-02x"} {
+		val := Date{}
+		err := val.Scan(in)
+		if err == nil || err.Error() != `cannot scan string into Date: invalid date: "`+in+`"` {
+			t.Fatalf("want %v, but %v:", `cannot scan string into Date: invalid date: "`+in+`"`, err)
+		}
+	}
+}
+
+func TestDateScanTypeError(t *testing.T) {
+	val := Date{}
+	err := val.Scan(struct{}{})
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+}
+
+func TestDateValue(t *testing.T) {
+	val := NewDate(2022, time.January, 2, true)
+	got, err := val.Value()
+	if got != "2022-01-02" || err != nil {
+		t.Fatalf("want %v, but %v:", "2022-01-02", got)
+	}
+}
+
+func TestDateValueNull(t *testing.T) {
+	val := NewDate(2022, time.January, 2, false)
+	got, err := val.Value()
+	if got != nil || err != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestDateMarshalJSON(t *testing.T) {
+	val := NewDate(2022, time.January, 2, true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `"2022-01-02"`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestDateMarshalJSONNull(t *testing.T) {
+	val := NewDate(2022, time.January, 2, false)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "null"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestDateUnmarshalJSON(t *testing.T) {
+	var val Date
+	err := json.NewDecoder(strings.NewReader(`"2022-01-02"`)).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDate(2022, time.January, 2, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDateUnmarshalJSONNull(t *testing.T) {
+	val := NewDate(2022, time.January, 2, true)
+	err := json.NewDecoder(strings.NewReader("null")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDate(0, 0, 0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDateUnmarshalJSONError(t *testing.T) {
+	for _, data := range []string{"foo", `"foo"`, "20220102"} {
+		val := Date{}
+		if err := val.UnmarshalJSON([]byte(data)); err == nil {
+			t.Fatalf("no error message is output: %v", data)
+		}
+	}
+}
+
+func TestDateMarshalText(t *testing.T) {
+	val := NewDate(12, time.December, 31, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "0012-12-31"
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestDateUnmarshalTextEmpty(t *testing.T) {
+	val := NewDate(2022, time.January, 2, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDate(0, 0, 0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDateIn(t *testing.T) {
+	val := NewDate(2022, time.January, 2, true)
+	got := val.In(time.UTC)
+	want := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestDateBeforeAfter(t *testing.T) {
+	d1 := NewDate(2021, time.December, 31, true)
+	d2 := NewDate(2022, time.January, 1, true)
+	if !d1.Before(d2) || d2.Before(d1) || d1.Before(d1) {
+		t.Fatal("Before is wrong")
+	}
+	if !d2.After(d1) || d1.After(d2) || d1.After(d1) {
+		t.Fatal("After is wrong")
+	}
+	if !NewDate(2022, time.January, 1, true).Before(NewDate(2022, time.January, 2, true)) {
+		t.Fatal("Before is wrong")
+	}
+}
+
+func TestDateIsNull(t *testing.T) {
+	val := NewDate(0, 0, 0, true)
+	if val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+
+	val = NewDate(0, 0, 0, false)
+	if !val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+}
+
+func TestDateFromPtr(t *testing.T) {
+	v := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	val := DateFromPtr(&v)
+	want := NewDate(2022, time.January, 2, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = DateFromPtr(nil)
+	want = Date{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDateFrom(t *testing.T) {
+	loc := time.FixedZone("UTC+9", 9*60*60)
+	val := DateFrom(time.Date(2022, 1, 2, 3, 4, 5, 0, loc))
+	want := NewDate(2022, time.January, 2, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDatePtr(t *testing.T) {
+	midnight := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	if got := NewDate(2022, time.January, 2, true).Ptr(); got == nil || !got.Equal(midnight) {
+		t.Fatalf("want %v, but %v:", midnight, got)
+	}
+	if got := NewDate(2022, time.January, 2, false).Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+
+	val := NewDate(2022, time.January, 2, true)
+	if got := DateFromPtr(val.Ptr()); got != val {
+		t.Fatalf("want %v, but %v:", val, got)
+	}
+}
+
+func TestDateValueOrZero(t *testing.T) {
+	midnight := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	if got := NewDate(2022, time.January, 2, true).ValueOrZero(); !got.Equal(midnight) {
+		t.Fatalf("want %v, but %v:", midnight, got)
+	}
+	if got := NewDate(2022, time.January, 2, false).ValueOrZero(); !got.IsZero() {
+		t.Fatalf("want %v, but %v:", time.Time{}, got)
+	}
+}
+
+func TestDateValueOr(t *testing.T) {
+	midnight := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	if got := NewDate(2022, time.January, 2, true).ValueOr(testTime); !got.Equal(midnight) {
+		t.Fatalf("want %v, but %v:", midnight, got)
+	}
+	if got := NewDate(2022, time.January, 2, false).ValueOr(testTime); !got.Equal(testTime) {
+		t.Fatalf("want %v, but %v:", testTime, got)
+	}
+}
@@ -0,0 +1,368 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal represents an exact decimal number that may be null, such as a NUMERIC or DECIMAL column.
+// The number is kept as its decimal digits, e.g. "-12.340", so no precision is lost.
+type Decimal struct {
+	Decimal string
+	Valid   bool
+}
+
+// NewDecimal creates a new Decimal
+func NewDecimal(d string, valid bool) Decimal {
+	return Decimal{Decimal: d, Valid: valid}
+}
+
+// DecimalFrom creates a new Decimal that is always valid.
+func DecimalFrom(d string) Decimal {
+	return NewDecimal(d, true)
+}
+
+// DecimalFromPtr creates a new Decimal that is null if d is nil.
+func DecimalFromPtr(d *string) Decimal {
+	if d == nil {
+		return Decimal{}
+	}
+	return NewDecimal(*d, true)
+}
+
+// Scan implements the Scanner interface.
+// Strings are normalized, so "+1.50e1" is scanned as "15.0".
+func (d *Decimal) Scan(value interface{}) error {
+	if value == nil {
+		d.Decimal, d.Valid = "", false
+		return nil
+	}
+
+	switch data := value.(type) {
+	case string:
+		dec, err := parseDecimal(data)
+		if err != nil {
+			return newScanError("Decimal", value, err)
+		}
+		d.Decimal, d.Valid = dec, true
+		return nil
+	case []byte:
+		dec, err := parseDecimal(string(data))
+		if err != nil {
+			return newScanError("Decimal", value, err)
+		}
+		d.Decimal, d.Valid = dec, true
+		return nil
+	case int:
+		d.Decimal, d.Valid = strconv.FormatInt(int64(data), 10), true
+		return nil
+	case int8:
+		d.Decimal, d.Valid = strconv.FormatInt(int64(data), 10), true
+		return nil
+	case int16:
+		d.Decimal, d.Valid = strconv.FormatInt(int64(data), 10), true
+		return nil
+	case int32:
+		d.Decimal, d.Valid = strconv.FormatInt(int64(data), 10), true
+		return nil
+	case int64:
+		d.Decimal, d.Valid = strconv.FormatInt(data, 10), true
+		return nil
+	case uint:
+		d.Decimal, d.Valid = strconv.FormatUint(uint64(data), 10), true
+		return nil
+	case uint8:
+		d.Decimal, d.Valid = strconv.FormatUint(uint64(data), 10), true
+		return nil
+	case uint16:
+		d.Decimal, d.Valid = strconv.FormatUint(uint64(data), 10), true
+		return nil
+	case uint32:
+		d.Decimal, d.Valid = strconv.FormatUint(uint64(data), 10), true
+		return nil
+	case uint64:
+		d.Decimal, d.Valid = strconv.FormatUint(data, 10), true
+		return nil
+	case float32:
+		if math.IsNaN(float64(data)) || math.IsInf(float64(data), 0) {
+			return newScanError("Decimal", value, fmt.Errorf("invalid decimal: %v", data))
+		}
+		d.Decimal, d.Valid = strconv.FormatFloat(float64(data), 'f', -1, 32), true
+		return nil
+	case float64:
+		if math.IsNaN(data) || math.IsInf(data, 0) {
+			return newScanError("Decimal", value, fmt.Errorf("invalid decimal: %v", data))
+		}
+		d.Decimal, d.Valid = strconv.FormatFloat(data, 'f', -1, 64), true
+		return nil
+	default:
+		return newScanError("Decimal", value, ErrUnsupportedType)
+	}
+}
+
+// Value implements the driver Valuer interface.
+// The value is the exact decimal string.
+func (d Decimal) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return parseDecimal(d.Decimal)
+}
+
+// MarshalJSON encode the value to JSON.
+// The value is a JSON number. Use DecimalString for a JSON string.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return appendDecimal(make([]byte, 0, len(d.Decimal)+2), d.Decimal)
+}
+
+// UnmarshalJSON decode data to the value.
+// Both JSON numbers and strings holding a number are accepted.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		d.Decimal, d.Valid = "", false
+		return nil
+	}
+	text := string(data)
+	if strings.HasPrefix(text, `"`) {
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+	}
+	return d.Scan(text)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (d Decimal) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	dec, err := parseDecimal(d.Decimal)
+	return []byte(dec), err
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		d.Decimal, d.Valid = "", false
+		return nil
+	}
+	return d.Scan(string(text))
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (d Decimal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, d.Valid, d)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (d *Decimal) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, d)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (d Decimal) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, d.Valid, d)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (d *Decimal) UnmarshalXMLAttr(attr xml.Attr) error {
+	return d.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+// The value is encoded as a string to keep its precision.
+func (d Decimal) MarshalYAML() (interface{}, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return parseDecimal(d.Decimal)
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (d *Decimal) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str *string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if str == nil {
+		d.Decimal, d.Valid = "", false
+		return nil
+	}
+	return d.Scan(*str)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (d Decimal) LogValue() slog.Value {
+	if !d.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(d.Decimal)
+}
+
+// Format implements the fmt.Formatter interface.
+func (d Decimal) Format(state fmt.State, verb rune) {
+	format(state, verb, d, d.Valid, d.Decimal)
+}
+
+// String implements the fmt.Stringer interface.
+func (d Decimal) String() string {
+	return fmt.Sprint(d)
+}
+
+// Rat returns the value as a big.Rat for exact arithmetic, or nil if it is null or not a number.
+func (d Decimal) Rat() *big.Rat {
+	if !d.Valid {
+		return nil
+	}
+	r, ok := new(big.Rat).SetString(d.Decimal)
+	if !ok {
+		return nil
+	}
+	return r
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (d Decimal) Ptr() *string {
+	if !d.Valid {
+		return nil
+	}
+	v := d.Decimal
+	return &v
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (d Decimal) ValueOrZero() string {
+	if !d.Valid {
+		var zero string
+		return zero
+	}
+	return d.Decimal
+}
+
+// ValueOr returns the value, or v if it is null.
+func (d Decimal) ValueOr(v string) string {
+	if !d.Valid {
+		return v
+	}
+	return d.Decimal
+}
+
+// IsNull returns true if Valid is false.
+func (d *Decimal) IsNull() bool {
+	return !d.Valid
+}
+
+// maxDecimalExponent bounds the exponent accepted by parseDecimal, so that a short
+// input such as "1e999999999" cannot expand to a huge digit string.
+const maxDecimalExponent = 1 << 17
+
+// parseDecimal validates the decimal number s, written with an optional sign,
+// digits with an optional decimal point and an optional exponent, and returns it
+// as plain digits without exponent, sign for zero or redundant leading zeros.
+// Trailing zeros of the fraction are significant and kept.
+func parseDecimal(s string) (string, error) {
+	dec, err := appendDecimal(make([]byte, 0, len(s)+2), s)
+	if err != nil {
+		return "", err
+	}
+	return string(dec), nil
+}
+
+// appendDecimal appends the decimal number s to dst in the form returned by parseDecimal.
+func appendDecimal(dst []byte, s string) ([]byte, error) {
+	rest := s
+	negative := false
+	if rest != "" && (rest[0] == '+' || rest[0] == '-') {
+		negative = rest[0] == '-'
+		rest = rest[1:]
+	}
+	mantissa, exponent := rest, ""
+	if i := strings.IndexAny(rest, "eE"); i >= 0 {
+		mantissa, exponent = rest[:i], rest[i+1:]
+		if exponent == "" {
+			return nil, invalidDecimal(s)
+		}
+	}
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	if intPart == "" && fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return nil, invalidDecimal(s)
+	}
+
+	point := len(intPart)
+	if exponent != "" {
+		exp, err := strconv.Atoi(exponent)
+		if err != nil || exp > maxDecimalExponent || exp < -maxDecimalExponent || !isDigits(strings.TrimLeft(exponent, "+-")) {
+			return nil, invalidDecimal(s)
+		}
+		point += exp
+	}
+
+	// digit returns the i-th digit of intPart followed by fracPart, and zeros after them.
+	n := len(intPart) + len(fracPart)
+	digit := func(i int) byte {
+		switch {
+		case i < len(intPart):
+			return intPart[i]
+		case i < n:
+			return fracPart[i-len(intPart)]
+		default:
+			return '0'
+		}
+	}
+
+	if negative && (strings.Trim(intPart, "0") != "" || strings.Trim(fracPart, "0") != "") {
+		dst = append(dst, '-')
+	}
+	if point <= 0 {
+		dst = append(dst, '0')
+	} else {
+		start := 0
+		for start < point-1 && digit(start) == '0' {
+			start++
+		}
+		for i := start; i < point; i++ {
+			dst = append(dst, digit(i))
+		}
+	}
+	if point < n {
+		dst = append(dst, '.')
+		for i := point; i < 0; i++ {
+			dst = append(dst, '0')
+		}
+		for i := max(point, 0); i < n; i++ {
+			dst = append(dst, digit(i))
+		}
+	}
+	return dst, nil
+}
+
+func invalidDecimal(s string) error {
+	return fmt.Errorf("invalid decimal: %q", s)
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+package null
+
+import "fmt"
+
+// DecimalString represents an exact decimal number that may be null and is encoded in JSON
+// as a string instead of a number, for clients that would lose precision parsing a JSON number.
+// UnmarshalJSON accepts both forms. It behaves like Decimal in every other respect.
+type DecimalString struct {
+	Decimal
+}
+
+// NewDecimalString creates a new DecimalString
+func NewDecimalString(d string, valid bool) DecimalString {
+	return DecimalString{Decimal: NewDecimal(d, valid)}
+}
+
+// DecimalStringFrom creates a new DecimalString that is always valid.
+func DecimalStringFrom(d string) DecimalString {
+	return NewDecimalString(d, true)
+}
+
+// DecimalStringFromPtr creates a new DecimalString that is null if d is nil.
+func DecimalStringFromPtr(d *string) DecimalString {
+	return DecimalString{Decimal: DecimalFromPtr(d)}
+}
+
+// MarshalJSON encode the value to JSON.
+func (d DecimalString) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	b := make([]byte, 0, len(d.Decimal.Decimal)+4)
+	b = append(b, '"')
+	b, err := appendDecimal(b, d.Decimal.Decimal)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '"'), nil
+}
+
+// Format implements the fmt.Formatter interface.
+func (d DecimalString) Format(state fmt.State, verb rune) {
+	format(state, verb, d, d.Valid, d.Decimal.Decimal)
+}
+
+// String implements the fmt.Stringer interface.
+func (d DecimalString) String() string {
+	return fmt.Sprint(d)
+}
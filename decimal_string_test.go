@@ -0,0 +1,69 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDecimalStringMarshalJSON(t *testing.T) {
+	val := struct {
+		Price  DecimalString `json:"price"`
+		Amount Decimal       `json:"amount"`
+		Tax    DecimalString `json:"tax"`
+	}{NewDecimalString("-1.10", true), NewDecimal("-1.10", true), DecimalString{}}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"price":"-1.10","amount":-1.10,"tax":null}`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestDecimalStringUnmarshalJSON(t *testing.T) {
+	for _, data := range []string{`"1.50"`, "1.50"} {
+		var val DecimalString
+		if err := json.Unmarshal([]byte(data), &val); err != nil {
+			t.Fatal(err)
+		}
+		if want := NewDecimalString("1.50", true); val != want {
+			t.Fatalf("want %v, but %v:", want, val)
+		}
+	}
+}
+
+func TestDecimalStringValue(t *testing.T) {
+	got, err := NewDecimalString("1.50", true).Value()
+	if got != "1.50" || err != nil {
+		t.Fatalf("want %v, but %v:", "1.50", got)
+	}
+}
+
+func TestDecimalStringFormat(t *testing.T) {
+	val := NewDecimalString("1.50", true)
+	if got := fmt.Sprint(val); got != "1.50" {
+		t.Fatalf("want %v, but %v:", "1.50", got)
+	}
+
+	want := `null.DecimalString{Decimal:null.Decimal{Decimal:"1.50", Valid:true}}`
+	if got := fmt.Sprintf("%#v", val); got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestDecimalStringFromPtr(t *testing.T) {
+	if got := DecimalStringFromPtr(nil); got != (DecimalString{}) {
+		t.Fatalf("want %v, but %v:", DecimalString{}, got)
+	}
+
+	d := "1.50"
+	if got, want := DecimalStringFromPtr(&d), DecimalStringFrom("1.50"); got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
@@ -0,0 +1,359 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestDecimalScanNull(t *testing.T) {
+	val := NewDecimal("1", true)
+	if err := val.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDecimal("", false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDecimalScanString(t *testing.T) {
+	val := Decimal{}
+	if err := val.Scan("12345678901234567890.123456789012345678900"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDecimal("12345678901234567890.123456789012345678900", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDecimalScanByte(t *testing.T) {
+	val := Decimal{}
+	if err := val.Scan([]byte("-0.10")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDecimal("-0.10", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDecimalScanNormalize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"0", "0"},
+		{"+1", "1"},
+		{"-0.00", "0.00"},
+		{"007.50", "7.50"},
+		{".5", "0.5"},
+		{"5.", "5"},
+		{"1.50e1", "15.0"},
+		{"1.5E+3", "1500"},
+		{"-12e-4", "-0.0012"},
+		{"0.001e2", "0.1"},
+	}
+	for _, tt := range tests {
+		val := Decimal{}
+		if err := val.Scan(tt.in); err != nil {
+			t.Fatal(err)
+		}
+		if val.Decimal != tt.want {
+			t.Fatalf("want %v, but %v:", tt.want, val.Decimal)
+		}
+	}
+}
+
+func TestDecimalScanInt(t *testing.T) {
+	val := Decimal{}
+	if err := val.Scan(int64(math.MinInt64)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDecimal("-9223372036854775808", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDecimalScanUint64(t *testing.T) {
+	val := Decimal{}
+	if err := val.Scan(uint64(math.MaxUint64)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDecimal("18446744073709551615", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDecimalScanFloat64(t *testing.T) {
+	val := Decimal{}
+	if err := val.Scan(0.1); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDecimal("0.1", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDecimalScanFloat64Error(t *testing.T) {
+	val := Decimal{}
+	err := val.Scan(math.NaN())
+	if err == nil || err.Error() != "cannot scan float64 into Decimal: invalid decimal: NaN" {
+		t.Fatalf("want %v, but %v:", "cannot scan float64 into Decimal: invalid decimal: NaN", err)
+	}
+}
+
+func TestDecimalScanStringParseError(t *testing.T) {
+	for _, in := range []string{"", "-", ".", "e1", "1e", "1e+", "1.2.3", "1,5", " 1", "NaN", "Infinity", "0x10", "1e999999999"} {
+		val := Decimal{}
+		if err := val.Scan(in); err == nil {
+			t.Fatalf("no error is output: %q", in)
+		}
+	}
+}
+
+func TestDecimalScanTypeError(t *testing.T) {
+	val := Decimal{}
+	err := val.Scan(struct{}{})
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+}
+
+func TestDecimalValue(t *testing.T) {
+	val := NewDecimal("1.10", true)
+	got, err := val.Value()
+	if got != "1.10" || err != nil {
+		t.Fatalf("want %v, but %v:", "1.10", got)
+	}
+}
+
+func TestDecimalValueError(t *testing.T) {
+	val := NewDecimal("foo", true)
+	_, err := val.Value()
+	if err == nil || err.Error() != `invalid decimal: "foo"` {
+		t.Fatalf("want %v, but %v:", `invalid decimal: "foo"`, err)
+	}
+}
+
+func TestDecimalValueNull(t *testing.T) {
+	val := NewDecimal("1", false)
+	got, err := val.Value()
+	if got != nil || err != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestDecimalMarshalJSONNumber(t *testing.T) {
+	val := NewDecimal("12345678901234567890.10", true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "12345678901234567890.10"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestDecimalMarshalJSONNull(t *testing.T) {
+	val := NewDecimal("1", false)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "null"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestDecimalMarshalJSONError(t *testing.T) {
+	val := NewDecimal("foo", true)
+	_, err := val.MarshalJSON()
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestDecimalUnmarshalJSONNumber(t *testing.T) {
+	var val Decimal
+	err := json.NewDecoder(strings.NewReader("0.30000000000000000001")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDecimal("0.30000000000000000001", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDecimalUnmarshalJSONString(t *testing.T) {
+	var val Decimal
+	err := json.NewDecoder(strings.NewReader(`"1.50"`)).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDecimal("1.50", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDecimalUnmarshalJSONNull(t *testing.T) {
+	val := NewDecimal("1", true)
+	err := json.NewDecoder(strings.NewReader("null")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDecimal("", false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDecimalUnmarshalJSONError(t *testing.T) {
+	for _, data := range []string{"foo", `"foo"`, `"1`, "true", "{}"} {
+		val := Decimal{}
+		if err := val.UnmarshalJSON([]byte(data)); err == nil {
+			t.Fatalf("no error message is output: %v", data)
+		}
+	}
+}
+
+func TestDecimalMarshalText(t *testing.T) {
+	val := NewDecimal("1.50", true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "1.50"
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestDecimalUnmarshalText(t *testing.T) {
+	var val Decimal
+	if err := val.UnmarshalText([]byte("1.50")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDecimal("1.50", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDecimalUnmarshalTextEmpty(t *testing.T) {
+	val := NewDecimal("1", true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDecimal("", false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDecimalRat(t *testing.T) {
+	val := NewDecimal("0.10", true)
+	got := val.Rat()
+	if got == nil || got.Cmp(big.NewRat(1, 10)) != 0 {
+		t.Fatalf("want %v, but %v:", big.NewRat(1, 10), got)
+	}
+
+	val = NewDecimal("0.10", false)
+	if got := val.Rat(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestDecimalIsNull(t *testing.T) {
+	val := NewDecimal("0", true)
+	if val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+
+	val = NewDecimal("", false)
+	if !val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+}
+
+func TestDecimalFrom(t *testing.T) {
+	val := DecimalFrom("1.5")
+	want := NewDecimal("1.5", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDecimalFromPtr(t *testing.T) {
+	v := "1.5"
+	val := DecimalFromPtr(&v)
+	want := NewDecimal("1.5", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = DecimalFromPtr(nil)
+	want = Decimal{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDecimalPtr(t *testing.T) {
+	val := NewDecimal("1.5", true)
+	got := val.Ptr()
+	if got == nil || *got != "1.5" {
+		t.Fatalf("want %v, but %v:", "1.5", got)
+	}
+
+	val = NewDecimal("1.5", false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestDecimalValueOr(t *testing.T) {
+	val := NewDecimal("1.5", true)
+	if got := val.ValueOrZero(); got != "1.5" {
+		t.Fatalf("want %v, but %v:", "1.5", got)
+	}
+	if got := val.ValueOr("2"); got != "1.5" {
+		t.Fatalf("want %v, but %v:", "1.5", got)
+	}
+
+	val = NewDecimal("1.5", false)
+	if got := val.ValueOrZero(); got != "" {
+		t.Fatalf("want %v, but %v:", "", got)
+	}
+	if got := val.ValueOr("2"); got != "2" {
+		t.Fatalf("want %v, but %v:", "2", got)
+	}
+}
@@ -0,0 +1,327 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration represents a time.Duration that may be null.
+type Duration struct {
+	Duration time.Duration
+	Valid    bool
+}
+
+// NewDuration creates a new Duration
+func NewDuration(d time.Duration, valid bool) Duration {
+	return Duration{Duration: d, Valid: valid}
+}
+
+// DurationFrom creates a new Duration that is always valid.
+func DurationFrom(d time.Duration) Duration {
+	return NewDuration(d, true)
+}
+
+// DurationFromPtr creates a new Duration that is null if d is nil.
+func DurationFromPtr(d *time.Duration) Duration {
+	if d == nil {
+		return Duration{}
+	}
+	return NewDuration(*d, true)
+}
+
+// Scan implements the Scanner interface.
+// An int64 is read as nanoseconds. Text is read as a Go duration string such as "1h30m",
+// or as a Postgres interval such as "1 day 02:03:04.5", where a day is 24 hours.
+// Intervals with years or months are rejected since their length varies.
+func (d *Duration) Scan(value interface{}) error {
+	if value == nil {
+		d.Duration, d.Valid = 0, false
+		return nil
+	}
+
+	switch data := value.(type) {
+	case int64:
+		d.Duration, d.Valid = time.Duration(data), true
+		return nil
+	case string:
+		dur, err := parseDuration(data)
+		if err != nil {
+			return newScanError("Duration", value, err)
+		}
+		d.Duration, d.Valid = dur, true
+		return nil
+	case []byte:
+		dur, err := parseDuration(string(data))
+		if err != nil {
+			return newScanError("Duration", value, err)
+		}
+		d.Duration, d.Valid = dur, true
+		return nil
+	default:
+		return newScanError("Duration", value, ErrUnsupportedType)
+	}
+}
+
+// Value implements the driver Valuer interface.
+// The value is int64 nanoseconds. Use Interval for an interval column.
+func (d Duration) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return int64(d.Duration), nil
+}
+
+// MarshalJSON encode the value to JSON.
+// The value is a duration string such as "1h30m0s". Use DurationNanos for a number of nanoseconds.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + d.Duration.String() + `"`), nil
+}
+
+// UnmarshalJSON decode data to the value.
+// Both numbers of nanoseconds and duration strings are accepted.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte(`"`)) {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return err
+		}
+		return d.Scan(str)
+	}
+	var n *int64
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	if n == nil {
+		d.Duration, d.Valid = 0, false
+		return nil
+	}
+	d.Duration, d.Valid = time.Duration(*n), true
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (d Duration) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return []byte(d.Duration.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (d *Duration) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		d.Duration, d.Valid = 0, false
+		return nil
+	}
+	return d.Scan(string(text))
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (d Duration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, d.Valid, d)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (d *Duration) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, d)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (d Duration) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, d.Valid, d)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (d *Duration) UnmarshalXMLAttr(attr xml.Attr) error {
+	return d.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (d Duration) MarshalYAML() (interface{}, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.Duration.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (d *Duration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str *string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if str == nil {
+		d.Duration, d.Valid = 0, false
+		return nil
+	}
+	return d.Scan(*str)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (d Duration) LogValue() slog.Value {
+	if !d.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.DurationValue(d.Duration)
+}
+
+// Format implements the fmt.Formatter interface.
+func (d Duration) Format(state fmt.State, verb rune) {
+	format(state, verb, d, d.Valid, d.Duration)
+}
+
+// String implements the fmt.Stringer interface.
+func (d Duration) String() string {
+	return fmt.Sprint(d)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (d Duration) Ptr() *time.Duration {
+	if !d.Valid {
+		return nil
+	}
+	v := d.Duration
+	return &v
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (d Duration) ValueOrZero() time.Duration {
+	if !d.Valid {
+		var zero time.Duration
+		return zero
+	}
+	return d.Duration
+}
+
+// ValueOr returns the value, or v if it is null.
+func (d Duration) ValueOr(v time.Duration) time.Duration {
+	if !d.Valid {
+		return v
+	}
+	return d.Duration
+}
+
+// IsNull returns true if Valid is false.
+func (d *Duration) IsNull() bool {
+	return !d.Valid
+}
+
+func parseDuration(s string) (time.Duration, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, nil
+	}
+	d, ok := parseInterval(s)
+	if !ok {
+		return 0, fmt.Errorf("invalid duration: %q", s)
+	}
+	return d, nil
+}
+
+// parseInterval parses the Postgres interval output "[N day[s]] [[+-]HH:MM:SS[.F]]".
+func parseInterval(s string) (time.Duration, bool) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return 0, false
+	}
+	var total time.Duration
+	for i := 0; i < len(fields); i++ {
+		var d time.Duration
+		if strings.Contains(fields[i], ":") {
+			var ok bool
+			if d, ok = parseClock(fields[i]); !ok {
+				return 0, false
+			}
+		} else {
+			if i+1 == len(fields) || fields[i+1] != "day" && fields[i+1] != "days" {
+				return 0, false
+			}
+			days, err := strconv.ParseInt(fields[i], 10, 64)
+			if err != nil || days > maxIntervalHours/24 || days < -maxIntervalHours/24 {
+				return 0, false
+			}
+			d = time.Duration(days) * 24 * time.Hour
+			i++
+		}
+		if d > 0 && total > time.Duration(1<<63-1)-d || d < 0 && total < time.Duration(-1<<63)-d {
+			return 0, false
+		}
+		total += d
+	}
+	return total, true
+}
+
+// maxIntervalHours is the number of hours a time.Duration can hold.
+const maxIntervalHours = int64(1<<63-1) / int64(time.Hour)
+
+// parseClock parses "[+-]HH:MM:SS[.F]", where HH may exceed 24.
+func parseClock(s string) (time.Duration, bool) {
+	negative := false
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return 0, false
+	}
+	sec, frac, _ := strings.Cut(parts[2], ".")
+	if len(frac) > 9 || !isDigits(frac) {
+		return 0, false
+	}
+	for _, part := range []string{parts[0], parts[1], sec} {
+		if part == "" || !isDigits(part) {
+			return 0, false
+		}
+	}
+	hours, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || hours >= maxIntervalHours {
+		return 0, false
+	}
+	minutes, _ := strconv.ParseInt(parts[1], 10, 64)
+	seconds, _ := strconv.ParseInt(sec, 10, 64)
+	if minutes > 59 || seconds > 59 {
+		return 0, false
+	}
+	nanos, _ := strconv.ParseInt(frac+strings.Repeat("0", 9-len(frac)), 10, 64)
+
+	d := time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute +
+		time.Duration(seconds)*time.Second + time.Duration(nanos)
+	if negative {
+		d = -d
+	}
+	return d, true
+}
+
+// formatInterval formats d as "[-]HH:MM:SS[.F]", which Postgres accepts as an interval.
+func formatInterval(d time.Duration) string {
+	sign := ""
+	u := uint64(d)
+	if d < 0 {
+		sign = "-"
+		u = -u
+	}
+	nanos := u % uint64(time.Second)
+	u /= uint64(time.Second)
+	text := fmt.Sprintf("%s%02d:%02d:%02d", sign, u/3600, u/60%60, u%60)
+	if nanos != 0 {
+		text += strings.TrimRight(fmt.Sprintf(".%09d", nanos), "0")
+	}
+	return text
+}
@@ -0,0 +1,46 @@
+package null
+
+import (
+	"fmt"
+	"time"
+)
+
+// DurationNanos represents a time.Duration that may be null and is encoded in JSON as a number
+// of nanoseconds instead of a duration string. UnmarshalJSON accepts both forms.
+// It behaves like Duration in every other respect.
+type DurationNanos struct {
+	Duration
+}
+
+// NewDurationNanos creates a new DurationNanos
+func NewDurationNanos(d time.Duration, valid bool) DurationNanos {
+	return DurationNanos{Duration: NewDuration(d, valid)}
+}
+
+// DurationNanosFrom creates a new DurationNanos that is always valid.
+func DurationNanosFrom(d time.Duration) DurationNanos {
+	return NewDurationNanos(d, true)
+}
+
+// DurationNanosFromPtr creates a new DurationNanos that is null if d is nil.
+func DurationNanosFromPtr(d *time.Duration) DurationNanos {
+	return DurationNanos{Duration: DurationFromPtr(d)}
+}
+
+// MarshalJSON encode the value to JSON.
+func (d DurationNanos) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return marshalJSONInt(int64(d.Duration.Duration)), nil
+}
+
+// Format implements the fmt.Formatter interface.
+func (d DurationNanos) Format(state fmt.State, verb rune) {
+	format(state, verb, d, d.Valid, d.Duration.Duration)
+}
+
+// String implements the fmt.Stringer interface.
+func (d DurationNanos) String() string {
+	return fmt.Sprint(d)
+}
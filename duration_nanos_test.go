@@ -0,0 +1,70 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDurationNanosMarshalJSON(t *testing.T) {
+	val := struct {
+		Timeout DurationNanos `json:"timeout"`
+		Elapsed Duration      `json:"elapsed"`
+		Retry   DurationNanos `json:"retry"`
+	}{NewDurationNanos(time.Second, true), NewDuration(time.Second, true), DurationNanos{}}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"timeout":1000000000,"elapsed":"1s","retry":null}`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestDurationNanosUnmarshalJSON(t *testing.T) {
+	for _, data := range []string{`"1s"`, "1000000000"} {
+		var val DurationNanos
+		if err := json.Unmarshal([]byte(data), &val); err != nil {
+			t.Fatal(err)
+		}
+		if want := NewDurationNanos(time.Second, true); val != want {
+			t.Fatalf("want %v, but %v:", want, val)
+		}
+	}
+}
+
+func TestDurationNanosValue(t *testing.T) {
+	got, err := NewDurationNanos(time.Second, true).Value()
+	if got != int64(time.Second) || err != nil {
+		t.Fatalf("want %v, but %v:", int64(time.Second), got)
+	}
+}
+
+func TestDurationNanosFormat(t *testing.T) {
+	val := NewDurationNanos(90*time.Minute, true)
+	if got := fmt.Sprint(val); got != "1h30m0s" {
+		t.Fatalf("want %v, but %v:", "1h30m0s", got)
+	}
+
+	want := "null.DurationNanos{Duration:null.Duration{Duration:5400000000000, Valid:true}}"
+	if got := fmt.Sprintf("%#v", val); got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestDurationNanosFromPtr(t *testing.T) {
+	if got := DurationNanosFromPtr(nil); got != (DurationNanos{}) {
+		t.Fatalf("want %v, but %v:", DurationNanos{}, got)
+	}
+
+	d := time.Second
+	if got, want := DurationNanosFromPtr(&d), DurationNanosFrom(time.Second); got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
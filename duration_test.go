@@ -0,0 +1,264 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDurationScanNull(t *testing.T) {
+	val := NewDuration(time.Second, true)
+	if err := val.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDuration(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDurationScanInt64(t *testing.T) {
+	val := Duration{}
+	if err := val.Scan(int64(1500)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDuration(1500*time.Nanosecond, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDurationScanString(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"1h30m", 90 * time.Minute},
+		{"-1.5s", -1500 * time.Millisecond},
+		{"01:30:00", 90 * time.Minute},
+		{"-00:00:01.5", -1500 * time.Millisecond},
+		{"100:00:00", 100 * time.Hour},
+		{"1 day", 24 * time.Hour},
+		{"3 days 02:03:04.000005", 74*time.Hour + 3*time.Minute + 4*time.Second + 5*time.Microsecond},
+		{"-1 days +02:00:00", -22 * time.Hour},
+	}
+	for _, tt := range tests {
+		val := Duration{}
+		if err := val.Scan(tt.in); err != nil {
+			t.Fatal(err)
+		}
+
+		want := NewDuration(tt.want, true)
+		if val != want {
+			t.Fatalf("%s: want %v, but %v:", tt.in, want, val)
+		}
+	}
+}
+
+func TestDurationScanByte(t *testing.T) {
+	val := Duration{}
+	if err := val.Scan([]byte("00:00:02")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDuration(2*time.Second, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDurationScanStringParseError(t *testing.T) {
+	for _, in := range []string{"", "foo", "1 year 2 mons", "3 mons 1 day", "1 day 1 day x", "01:60:00",
+		"01:00", "01:00:00.1234567890", "2562048:00:00", "106751 days 23:59:59", "1 days 01:00:0x"} {
+		val := Duration{}
+		err := val.Scan(in)
+		if err == nil || err.Error() != `cannot scan string into Duration: invalid duration: "`+in+`"` {
+			t.Fatalf("want %v, but %v:", `cannot scan string into Duration: invalid duration: "`+in+`"`, err)
+		}
+	}
+}
+
+func TestDurationScanTypeError(t *testing.T) {
+	val := Duration{}
+	err := val.Scan(1.5)
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+}
+
+func TestDurationValue(t *testing.T) {
+	val := NewDuration(time.Second, true)
+	got, err := val.Value()
+	if got != int64(time.Second) || err != nil {
+		t.Fatalf("want %v, but %v:", int64(time.Second), got)
+	}
+}
+
+func TestDurationValueNull(t *testing.T) {
+	val := NewDuration(time.Second, false)
+	got, err := val.Value()
+	if got != nil || err != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestDurationMarshalJSON(t *testing.T) {
+	val := NewDuration(90*time.Minute, true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `"1h30m0s"`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestDurationMarshalJSONNull(t *testing.T) {
+	val := NewDuration(time.Second, false)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "null"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestDurationUnmarshalJSON(t *testing.T) {
+	for _, data := range []string{`"1s"`, "1000000000", `"00:00:01"`} {
+		var val Duration
+		err := json.NewDecoder(strings.NewReader(data)).Decode(&val)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := NewDuration(time.Second, true)
+		if val != want {
+			t.Fatalf("want %v, but %v:", want, val)
+		}
+	}
+}
+
+func TestDurationUnmarshalJSONNull(t *testing.T) {
+	val := NewDuration(time.Second, true)
+	err := json.NewDecoder(strings.NewReader("null")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDuration(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDurationUnmarshalJSONError(t *testing.T) {
+	for _, data := range []string{"foo", `"foo"`, "1.5", "true"} {
+		val := Duration{}
+		if err := val.UnmarshalJSON([]byte(data)); err == nil {
+			t.Fatalf("no error message is output: %v", data)
+		}
+	}
+}
+
+func TestDurationMarshalText(t *testing.T) {
+	val := NewDuration(90*time.Minute, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "1h30m0s"
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestDurationUnmarshalTextEmpty(t *testing.T) {
+	val := NewDuration(time.Second, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewDuration(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDurationIsNull(t *testing.T) {
+	val := NewDuration(0, true)
+	if val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+
+	val = NewDuration(0, false)
+	if !val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+}
+
+func TestDurationFrom(t *testing.T) {
+	val := DurationFrom(time.Second)
+	want := NewDuration(time.Second, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDurationFromPtr(t *testing.T) {
+	v := time.Second
+	val := DurationFromPtr(&v)
+	want := NewDuration(time.Second, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = DurationFromPtr(nil)
+	want = Duration{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestDurationPtr(t *testing.T) {
+	val := NewDuration(time.Second, true)
+	got := val.Ptr()
+	if got == nil || *got != time.Second {
+		t.Fatalf("want %v, but %v:", time.Second, got)
+	}
+
+	val = NewDuration(time.Second, false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestDurationValueOr(t *testing.T) {
+	val := NewDuration(time.Second, true)
+	if got := val.ValueOrZero(); got != time.Second {
+		t.Fatalf("want %v, but %v:", time.Second, got)
+	}
+	if got := val.ValueOr(time.Minute); got != time.Second {
+		t.Fatalf("want %v, but %v:", time.Second, got)
+	}
+
+	val = NewDuration(time.Second, false)
+	if got := val.ValueOrZero(); got != time.Duration(0) {
+		t.Fatalf("want %v, but %v:", time.Duration(0), got)
+	}
+	if got := val.ValueOr(time.Minute); got != time.Minute {
+		t.Fatalf("want %v, but %v:", time.Minute, got)
+	}
+}
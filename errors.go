@@ -0,0 +1,49 @@
+package null
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var (
+	// ErrUnsupportedType is reported when Scan is given a value of a type it cannot convert.
+	ErrUnsupportedType = errors.New("unsupported type")
+
+	// ErrOutOfRange is reported when a number does not fit in the type it is scanned into.
+	ErrOutOfRange = errors.New("value out of range")
+
+	// ErrFractional is reported when a float with a fractional part is scanned into an integer type.
+	ErrFractional = errors.New("fractional value")
+)
+
+// ScanError is returned by Scan when a value cannot be scanned.
+// Use errors.Is with ErrUnsupportedType, ErrOutOfRange or ErrFractional, or errors.As with
+// the type of Err, such as *OverflowError or *strconv.NumError, to tell the causes apart.
+type ScanError struct {
+	// Target is the name of the type the value was scanned into, e.g. "Int8".
+	Target string
+	// Source is the Go type of the value, e.g. "int64".
+	Source string
+	// Value is the value that was passed to Scan.
+	Value interface{}
+	// Err is the cause.
+	Err error
+}
+
+func newScanError(target string, value interface{}, err error) *ScanError {
+	return &ScanError{Target: target, Source: fmt.Sprintf("%T", value), Value: value, Err: err}
+}
+
+func (e *ScanError) Error() string {
+	return fmt.Sprintf("cannot scan %s into %s: %v", e.Source, e.Target, e.Err)
+}
+
+func (e *ScanError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports a number that strconv found out of range as ErrOutOfRange.
+func (e *ScanError) Is(target error) bool {
+	return target == ErrOutOfRange && errors.Is(e.Err, strconv.ErrRange)
+}
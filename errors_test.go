@@ -0,0 +1,151 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestScanErrorUnsupportedType(t *testing.T) {
+	val := Int8{}
+	err := val.Scan(struct{}{})
+
+	var scanErr *ScanError
+	if !errors.As(err, &scanErr) {
+		t.Fatalf("want a *ScanError, but %v:", err)
+	}
+	if scanErr.Target != "Int8" || scanErr.Source != "struct {}" || scanErr.Value != struct{}{} {
+		t.Fatalf("unexpected fields: %#v", scanErr)
+	}
+	if !errors.Is(err, ErrUnsupportedType) || errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+
+	want := "cannot scan struct {} into Int8: unsupported type"
+	if err.Error() != want {
+		t.Fatalf("want %v, but %v:", want, err.Error())
+	}
+}
+
+func TestScanErrorOutOfRange(t *testing.T) {
+	val := Int8{}
+	err := val.Scan(int64(128))
+	if !errors.Is(err, ErrOutOfRange) || errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
+	}
+
+	var overflow *OverflowError
+	if !errors.As(err, &overflow) || overflow.Target != "Int8" || overflow.Value != int64(128) {
+		t.Fatalf("want an *OverflowError, but %v:", err)
+	}
+}
+
+func TestScanErrorFractional(t *testing.T) {
+	val := Int8{}
+	err := val.Scan(1.5)
+	if !errors.Is(err, ErrFractional) || errors.Is(err, ErrOutOfRange) || errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrFractional, err)
+	}
+
+	want := "cannot scan float64 into Int8: fractional value for Int8: 1.5"
+	if err.Error() != want {
+		t.Fatalf("want %v, but %v:", want, err.Error())
+	}
+}
+
+func TestScanErrorStringOutOfRange(t *testing.T) {
+	val := Uint8{}
+	err := val.Scan("256")
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
+	}
+
+	var overflow *OverflowError
+	if !errors.As(err, &overflow) || overflow.Target != "Uint8" || overflow.Value != "256" {
+		t.Fatalf("want an *OverflowError, but %v:", err)
+	}
+
+	i8 := Int8{}
+	err = i8.Scan([]byte("300"))
+	if !errors.As(err, &overflow) || overflow.Target != "Int8" {
+		t.Fatalf("want an *OverflowError, but %v:", err)
+	}
+}
+
+func TestScanErrorParse(t *testing.T) {
+	val := Bool{}
+	err := val.Scan("foo")
+	if errors.Is(err, ErrOutOfRange) || errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("unexpected cause: %v", err)
+	}
+
+	var scanErr *ScanError
+	if !errors.As(err, &scanErr) || scanErr.Target != "Bool" || scanErr.Value != "foo" {
+		t.Fatalf("want a *ScanError, but %v:", err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Fatalf("want %v, but %v:", strconv.ErrSyntax, err)
+	}
+}
+
+func TestScanErrorValue(t *testing.T) {
+	val := Value[uint8]{}
+	err := val.Scan(int64(256))
+
+	var scanErr *ScanError
+	if !errors.As(err, &scanErr) || scanErr.Target != "uint8" || scanErr.Source != "int64" {
+		t.Fatalf("want a *ScanError, but %v:", err)
+	}
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
+	}
+
+	id := Value[testID]{}
+	err = id.Scan("1")
+	if !errors.As(err, &scanErr) || scanErr.Target != "null.testID" || scanErr.Err.Error() != "not an int64" {
+		t.Fatalf("want a *ScanError, but %v:", err)
+	}
+}
+
+func TestScanErrorUnmarshalJSON(t *testing.T) {
+	var val struct {
+		Date Date `json:"date"`
+	}
+	err := json.Unmarshal([]byte(`{"date":"2022-02-30"}`), &val)
+
+	var scanErr *ScanError
+	if !errors.As(err, &scanErr) || scanErr.Target != "Date" || scanErr.Value != "2022-02-30" {
+		t.Fatalf("want a *ScanError, but %v:", err)
+	}
+}
+
+func TestScanErrorBoolOutOfRange(t *testing.T) {
+	val := Bool{}
+	err := val.Scan(int64(2))
+	if !errors.Is(err, ErrOutOfRange) || errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
+	}
+
+	var overflow *OverflowError
+	if !errors.As(err, &overflow) || overflow.Target != "Bool" || overflow.Value != int64(2) {
+		t.Fatalf("want an *OverflowError, but %v:", err)
+	}
+}
+
+func TestScanErrorUnmarshalJSONDecimalUUID(t *testing.T) {
+	var val struct {
+		Price Decimal `json:"price"`
+		ID    UUID    `json:"id"`
+	}
+	var scanErr *ScanError
+	err := json.Unmarshal([]byte(`{"price":"1.2.3"}`), &val)
+	if !errors.As(err, &scanErr) || scanErr.Target != "Decimal" || scanErr.Value != "1.2.3" {
+		t.Fatalf("want a *ScanError, but %v:", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"id":"foo"}`), &val)
+	if !errors.As(err, &scanErr) || scanErr.Target != "UUID" || scanErr.Value != "foo" {
+		t.Fatalf("want a *ScanError, but %v:", err)
+	}
+}
@@ -2,21 +2,14 @@ package null
 
 import (
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/xml"
 	"fmt"
-	"strconv"
+	"log/slog"
 )
 
 // Float32 represents a float32 that may be null.
-// When this structure is used to pass data to and from the DB, unintended data may be retrieved.
-// This is due to the following implementation.
-//
-//	func (f Float32) Value() (driver.Value, error) {
-//		if !f.Valid {
-//			return nil, nil
-//		}
-//		return float64(f.Float32), nil
-//	}
+// Value passes the number to the driver as a float64, since driver.Value cannot hold a float32,
+// so a value that is stored and read back may not equal the float32 that was written.
 type Float32 struct {
 	Float32 float32
 	Valid   bool
@@ -27,83 +20,136 @@ func NewFloat32(f32 float32, valid bool) Float32 {
 	return Float32{Float32: f32, Valid: valid}
 }
 
-// Scan implements the Scanner interface.
-func (f *Float32) Scan(value interface{}) error {
-	if value == nil {
-		f.Float32, f.Valid = 0, false
-		return nil
+// Float32From creates a new Float32 that is always valid.
+func Float32From(f float32) Float32 {
+	return NewFloat32(f, true)
+}
+
+// Float32FromPtr creates a new Float32 that is null if f is nil.
+func Float32FromPtr(f *float32) Float32 {
+	if f == nil {
+		return Float32{}
 	}
+	return NewFloat32(*f, true)
+}
 
-	f.Valid = true
-	switch data := value.(type) {
-	case string:
-		f32, err := strconv.ParseFloat(data, 32)
-		if err != nil {
-			return err
-		}
-		f.Float32 = float32(f32)
-		return nil
-	case []byte:
-		f32, err := strconv.ParseFloat(string(data), 32)
-		if err != nil {
-			return err
-		}
-		f.Float32 = float32(f32)
-		return nil
-	case int:
-		f.Float32 = float32(data)
-		return nil
-	case int8:
-		f.Float32 = float32(data)
-		return nil
-	case int16:
-		f.Float32 = float32(data)
-		return nil
-	case int32:
-		f.Float32 = float32(data)
-		return nil
-	case int64:
-		f.Float32 = float32(data)
-		return nil
-	case float32:
-		f.Float32 = data
-		return nil
-	default:
-		return fmt.Errorf("unsupported type: %T", value)
+// Scan implements the Scanner interface.
+func (f *Float32) Scan(value interface{}) error {
+	n := Value[float32]{}
+	if err := n.scan("Float32", value); err != nil {
+		return err
 	}
+	f.Float32, f.Valid = n.V, n.Valid
+	return nil
 }
 
 // Value implements the driver Valuer interface.
 func (f Float32) Value() (driver.Value, error) {
-	if !f.Valid {
-		return nil, nil
-	}
-	return float64(f.Float32), nil
+	return Value[float32]{V: f.Float32, Valid: f.Valid}.Value()
 }
 
 // MarshalJSON encode the value to JSON.
 func (f Float32) MarshalJSON() ([]byte, error) {
-	if !f.Valid {
-		return []byte("null"), nil
-	}
-	return jsonMarshal(f.Float32)
+	return Value[float32]{V: f.Float32, Valid: f.Valid}.MarshalJSON()
 }
 
 // UnmarshalJSON decode data to the value.
+// A quoted value is accepted as well if LenientJSON is true.
 func (f *Float32) UnmarshalJSON(data []byte) error {
-	var f32 *float32
-	if err := json.Unmarshal(data, &f32); err != nil {
+	n := Value[float32]{}
+	if err := n.unmarshalJSON("Float32", data); err != nil {
 		return err
 	}
-	f.Valid = f32 != nil
-	if f.Valid {
-		f.Float32 = *f32
-	} else {
-		f.Float32 = 0
+	f.Float32, f.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (f Float32) MarshalText() ([]byte, error) {
+	return Value[float32]{V: f.Float32, Valid: f.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (f *Float32) UnmarshalText(text []byte) error {
+	n := Value[float32]{}
+	if err := n.unmarshalText("Float32", text); err != nil {
+		return err
+	}
+	f.Float32, f.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (f Float32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, f.Valid, f)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (f *Float32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, f)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (f Float32) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, f.Valid, f)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (f *Float32) UnmarshalXMLAttr(attr xml.Attr) error {
+	return f.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (f Float32) MarshalYAML() (interface{}, error) {
+	return Value[float32]{V: f.Float32, Valid: f.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (f *Float32) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[float32]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
 	}
+	f.Float32, f.Valid = n.V, n.Valid
 	return nil
 }
 
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (f Float32) LogValue() slog.Value {
+	return Value[float32]{V: f.Float32, Valid: f.Valid}.LogValue()
+}
+
+// Format implements the fmt.Formatter interface.
+func (f Float32) Format(state fmt.State, verb rune) {
+	format(state, verb, f, f.Valid, f.Float32)
+}
+
+// String implements the fmt.Stringer interface.
+func (f Float32) String() string {
+	return fmt.Sprint(f)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (f Float32) Ptr() *float32 {
+	return Value[float32]{V: f.Float32, Valid: f.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (f Float32) ValueOrZero() float32 {
+	return Value[float32]{V: f.Float32, Valid: f.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (f Float32) ValueOr(v float32) float32 {
+	return Value[float32]{V: f.Float32, Valid: f.Valid}.ValueOr(v)
+}
+
 // IsNull returns true if Valid is false.
 func (f *Float32) IsNull() bool {
 	return !f.Valid
@@ -3,6 +3,7 @@ package null
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 )
@@ -140,8 +141,8 @@ func TestFloat32ScanStringByteError(t *testing.T) {
 func TestFloat32ScanTypeError(t *testing.T) {
 	val := Float32{}
 	err := val.Scan(struct{}{})
-	if err == nil || err.Error() != "unsupported type: struct {}" {
-		t.Fatalf("want %v, but %v:", "unsupported type: struct {}", err)
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
 	}
 }
 
@@ -258,6 +259,63 @@ func TestFloat32UnmarshalJSONError(t *testing.T) {
 	}
 }
 
+func TestFloat32MarshalText(t *testing.T) {
+	val := NewFloat32(1.5, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `1.5`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestFloat32MarshalTextNull(t *testing.T) {
+	val := NewFloat32(1, false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestFloat32UnmarshalText(t *testing.T) {
+	var val Float32
+	if err := val.UnmarshalText([]byte(`1.5`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewFloat32(1.5, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestFloat32UnmarshalTextEmpty(t *testing.T) {
+	val := NewFloat32(1, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewFloat32(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestFloat32UnmarshalTextError(t *testing.T) {
+	val := Float32{}
+	err := val.UnmarshalText([]byte("foo"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
 func TestFloat32IsNull(t *testing.T) {
 	val := NewFloat32(0, true)
 	if val.IsNull() {
@@ -269,3 +327,57 @@ func TestFloat32IsNull(t *testing.T) {
 		t.Fatal("it has to be not null")
 	}
 }
+
+func TestFloat32From(t *testing.T) {
+	val := Float32From(float32(1.5))
+	want := NewFloat32(float32(1.5), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestFloat32FromPtr(t *testing.T) {
+	v := float32(1.5)
+	val := Float32FromPtr(&v)
+	want := NewFloat32(float32(1.5), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = Float32FromPtr(nil)
+	want = Float32{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestFloat32Ptr(t *testing.T) {
+	val := NewFloat32(float32(1.5), true)
+	got := val.Ptr()
+	if got == nil || *got != float32(1.5) {
+		t.Fatalf("want %v, but %v:", float32(1.5), got)
+	}
+
+	val = NewFloat32(float32(1.5), false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestFloat32ValueOr(t *testing.T) {
+	val := NewFloat32(float32(1.5), true)
+	if got := val.ValueOrZero(); got != float32(1.5) {
+		t.Fatalf("want %v, but %v:", float32(1.5), got)
+	}
+	if got := val.ValueOr(float32(2.5)); got != float32(1.5) {
+		t.Fatalf("want %v, but %v:", float32(1.5), got)
+	}
+
+	val = NewFloat32(float32(1.5), false)
+	if got := val.ValueOrZero(); got != float32(0) {
+		t.Fatalf("want %v, but %v:", float32(0), got)
+	}
+	if got := val.ValueOr(float32(2.5)); got != float32(2.5) {
+		t.Fatalf("want %v, but %v:", float32(2.5), got)
+	}
+}
@@ -1,10 +1,11 @@
 package null
 
 import (
+	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/xml"
 	"fmt"
-	"strconv"
+	"log/slog"
 )
 
 // Float64 represents a float64 that may be null.
@@ -18,83 +19,146 @@ func NewFloat64(f64 float64, valid bool) Float64 {
 	return Float64{Float64: f64, Valid: valid}
 }
 
-// Scan implements the Scanner interface.
-func (f *Float64) Scan(value interface{}) error {
-	if value == nil {
-		f.Float64, f.Valid = 0, false
-		return nil
+// Float64From creates a new Float64 that is always valid.
+func Float64From(f float64) Float64 {
+	return NewFloat64(f, true)
+}
+
+// Float64FromPtr creates a new Float64 that is null if f is nil.
+func Float64FromPtr(f *float64) Float64 {
+	if f == nil {
+		return Float64{}
 	}
+	return NewFloat64(*f, true)
+}
+
+// Float64FromSQL creates a new Float64 from a sql.NullFloat64.
+func Float64FromSQL(f sql.NullFloat64) Float64 {
+	return NewFloat64(f.Float64, f.Valid)
+}
 
-	f.Valid = true
-	switch data := value.(type) {
-	case string:
-		f64, err := strconv.ParseFloat(data, 64)
-		if err != nil {
-			return err
-		}
-		f.Float64 = f64
-		return nil
-	case []byte:
-		f64, err := strconv.ParseFloat(string(data), 64)
-		if err != nil {
-			return err
-		}
-		f.Float64 = f64
-		return nil
-	case int:
-		f.Float64 = float64(data)
-		return nil
-	case int8:
-		f.Float64 = float64(data)
-		return nil
-	case int16:
-		f.Float64 = float64(data)
-		return nil
-	case int32:
-		f.Float64 = float64(data)
-		return nil
-	case int64:
-		f.Float64 = float64(data)
-		return nil
-	case float64:
-		f.Float64 = data
-		return nil
-	default:
-		return fmt.Errorf("unsupported type: %T", value)
+// Scan implements the Scanner interface.
+func (f *Float64) Scan(value interface{}) error {
+	n := Value[float64]{}
+	if err := n.scan("Float64", value); err != nil {
+		return err
 	}
+	f.Float64, f.Valid = n.V, n.Valid
+	return nil
 }
 
 // Value implements the driver Valuer interface.
 func (f Float64) Value() (driver.Value, error) {
-	if !f.Valid {
-		return nil, nil
-	}
-	return f.Float64, nil
+	return Value[float64]{V: f.Float64, Valid: f.Valid}.Value()
 }
 
 // MarshalJSON encode the value to JSON.
 func (f Float64) MarshalJSON() ([]byte, error) {
-	if !f.Valid {
-		return []byte("null"), nil
-	}
-	return jsonMarshal(f.Float64)
+	return Value[float64]{V: f.Float64, Valid: f.Valid}.MarshalJSON()
 }
 
 // UnmarshalJSON decode data to the value.
+// A quoted value is accepted as well if LenientJSON is true.
 func (f *Float64) UnmarshalJSON(data []byte) error {
-	var f64 *float64
-	if err := json.Unmarshal(data, &f64); err != nil {
+	n := Value[float64]{}
+	if err := n.unmarshalJSON("Float64", data); err != nil {
+		return err
+	}
+	f.Float64, f.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (f Float64) MarshalText() ([]byte, error) {
+	return Value[float64]{V: f.Float64, Valid: f.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (f *Float64) UnmarshalText(text []byte) error {
+	n := Value[float64]{}
+	if err := n.unmarshalText("Float64", text); err != nil {
 		return err
 	}
-	f.Valid = f64 != nil
-	if f.Valid {
-		f.Float64 = *f64
-	} else {
-		f.Float64 = 0
+	f.Float64, f.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (f Float64) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, f.Valid, f)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (f *Float64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, f)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (f Float64) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, f.Valid, f)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (f *Float64) UnmarshalXMLAttr(attr xml.Attr) error {
+	return f.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (f Float64) MarshalYAML() (interface{}, error) {
+	return Value[float64]{V: f.Float64, Valid: f.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (f *Float64) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[float64]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
 	}
+	f.Float64, f.Valid = n.V, n.Valid
 	return nil
 }
 
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (f Float64) LogValue() slog.Value {
+	return Value[float64]{V: f.Float64, Valid: f.Valid}.LogValue()
+}
+
+// Format implements the fmt.Formatter interface.
+func (f Float64) Format(state fmt.State, verb rune) {
+	format(state, verb, f, f.Valid, f.Float64)
+}
+
+// String implements the fmt.Stringer interface.
+func (f Float64) String() string {
+	return fmt.Sprint(f)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (f Float64) Ptr() *float64 {
+	return Value[float64]{V: f.Float64, Valid: f.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (f Float64) ValueOrZero() float64 {
+	return Value[float64]{V: f.Float64, Valid: f.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (f Float64) ValueOr(v float64) float64 {
+	return Value[float64]{V: f.Float64, Valid: f.Valid}.ValueOr(v)
+}
+
+// ToSQL returns the value as a sql.NullFloat64.
+func (f Float64) ToSQL() sql.NullFloat64 {
+	return sql.NullFloat64{Float64: f.Float64, Valid: f.Valid}
+}
+
 // IsNull returns true if Valid is false.
 func (f *Float64) IsNull() bool {
 	return !f.Valid
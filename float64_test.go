@@ -2,7 +2,9 @@ package null
 
 import (
 	"bytes"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 )
@@ -140,8 +142,8 @@ func TestFloat64ScanStringByteError(t *testing.T) {
 func TestFloat64ScanTypeError(t *testing.T) {
 	val := Float64{}
 	err := val.Scan(struct{}{})
-	if err == nil || err.Error() != "unsupported type: struct {}" {
-		t.Fatalf("want %v, but %v:", "unsupported type: struct {}", err)
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
 	}
 }
 
@@ -258,6 +260,63 @@ func TestFloat64UnmarshalJSONError(t *testing.T) {
 	}
 }
 
+func TestFloat64MarshalText(t *testing.T) {
+	val := NewFloat64(1.5, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `1.5`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestFloat64MarshalTextNull(t *testing.T) {
+	val := NewFloat64(1, false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestFloat64UnmarshalText(t *testing.T) {
+	var val Float64
+	if err := val.UnmarshalText([]byte(`1.5`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewFloat64(1.5, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestFloat64UnmarshalTextEmpty(t *testing.T) {
+	val := NewFloat64(1, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewFloat64(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestFloat64UnmarshalTextError(t *testing.T) {
+	val := Float64{}
+	err := val.UnmarshalText([]byte("foo"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
 func TestFloat64IsNull(t *testing.T) {
 	val := NewFloat64(0, true)
 	if val.IsNull() {
@@ -269,3 +328,81 @@ func TestFloat64IsNull(t *testing.T) {
 		t.Fatal("it has to be not null")
 	}
 }
+
+func TestFloat64From(t *testing.T) {
+	val := Float64From(1.5)
+	want := NewFloat64(1.5, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestFloat64FromPtr(t *testing.T) {
+	v := 1.5
+	val := Float64FromPtr(&v)
+	want := NewFloat64(1.5, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = Float64FromPtr(nil)
+	want = Float64{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestFloat64Ptr(t *testing.T) {
+	val := NewFloat64(1.5, true)
+	got := val.Ptr()
+	if got == nil || *got != 1.5 {
+		t.Fatalf("want %v, but %v:", 1.5, got)
+	}
+
+	val = NewFloat64(1.5, false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestFloat64ValueOr(t *testing.T) {
+	val := NewFloat64(1.5, true)
+	if got := val.ValueOrZero(); got != 1.5 {
+		t.Fatalf("want %v, but %v:", 1.5, got)
+	}
+	if got := val.ValueOr(2.5); got != 1.5 {
+		t.Fatalf("want %v, but %v:", 1.5, got)
+	}
+
+	val = NewFloat64(1.5, false)
+	if got := val.ValueOrZero(); got != 0.0 {
+		t.Fatalf("want %v, but %v:", 0.0, got)
+	}
+	if got := val.ValueOr(2.5); got != 2.5 {
+		t.Fatalf("want %v, but %v:", 2.5, got)
+	}
+}
+
+func TestFloat64FromSQL(t *testing.T) {
+	val := Float64FromSQL(sql.NullFloat64{Float64: 1.5, Valid: true})
+	want := NewFloat64(1.5, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = Float64FromSQL(sql.NullFloat64{Float64: 1.5, Valid: false})
+	want = NewFloat64(1.5, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestFloat64ToSQL(t *testing.T) {
+	for _, valid := range []bool{true, false} {
+		got := NewFloat64(1.5, valid).ToSQL()
+		want := sql.NullFloat64{Float64: 1.5, Valid: valid}
+		if got != want {
+			t.Fatalf("want %v, but %v:", want, got)
+		}
+	}
+}
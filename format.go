@@ -0,0 +1,48 @@
+package null
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// NullPlaceholder is printed by the fmt package in place of a null value.
+var NullPlaceholder = "<null>"
+
+// format prints v, the underlying value of self, for the fmt.Formatter interface.
+// %#v prints self in Go syntax, and any other verb prints NullPlaceholder if self is null.
+// %s prints a value that is not a string, []byte or fmt.Stringer like %v.
+func format(f fmt.State, verb rune, self interface{}, valid bool, v interface{}) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		formatGoSyntax(f, self)
+	case !valid:
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), NullPlaceholder)
+	case verb == 's' && !isStringLike(v):
+		fmt.Fprintf(f, fmt.FormatString(f, 'v'), v)
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), v)
+	}
+}
+
+// isStringLike reports whether v is printed as text by %s.
+func isStringLike(v interface{}) bool {
+	switch v.(type) {
+	case string, []byte, fmt.Stringer, error:
+		return true
+	default:
+		return false
+	}
+}
+
+// formatGoSyntax prints the struct v as %#v does for a type without a Format method.
+func formatGoSyntax(f fmt.State, v interface{}) {
+	rv := reflect.ValueOf(v)
+	fmt.Fprintf(f, "%T{", v)
+	for i := 0; i < rv.NumField(); i++ {
+		if i > 0 {
+			fmt.Fprint(f, ", ")
+		}
+		fmt.Fprintf(f, "%s:%#v", rv.Type().Field(i).Name, rv.Field(i).Interface())
+	}
+	fmt.Fprint(f, "}")
+}
@@ -0,0 +1,129 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		val  interface{}
+		want string
+	}{
+		{NewString("foo", true), "foo"},
+		{NewBool(true, true), "true"},
+		{NewByte(1, true), "1"},
+		{NewInt(-1, true), "-1"},
+		{NewInt8(-8, true), "-8"},
+		{NewInt16(-16, true), "-16"},
+		{NewInt32(-32, true), "-32"},
+		{NewInt64(-64, true), "-64"},
+		{NewUint(1, true), "1"},
+		{NewUint8(8, true), "8"},
+		{NewUint16(16, true), "16"},
+		{NewUint32(32, true), "32"},
+		{NewUint64(64, true), "64"},
+		{NewFloat32(1.5, true), "1.5"},
+		{NewFloat64(2.5, true), "2.5"},
+		{NewTime(time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC), true), "2022-01-02 03:04:05 +0000 UTC"},
+		{NewBytes([]byte("foo"), true), "[102 111 111]"},
+		{NewJSON(json.RawMessage(`{"foo":1}`), true), `{"foo":1}`},
+		{NewDecimal("1.50", true), "1.50"},
+		{NewValue("bar", true), "bar"},
+		{NewOptional(2, true), "2"},
+		{NewRedactedString("foo", true), "[REDACTED]"},
+		{String{}, "<null>"},
+		{Int{}, "<null>"},
+		{Time{}, "<null>"},
+		{JSON{}, "<null>"},
+		{Value[int]{}, "<null>"},
+		{RedactedString{}, "<null>"},
+	}
+	for _, tt := range tests {
+		got := fmt.Sprintf("%v", tt.val)
+		if got != tt.want {
+			t.Fatalf("want %v, but %v:", tt.want, got)
+		}
+	}
+}
+
+func TestFormatVerbs(t *testing.T) {
+	tests := []struct {
+		format string
+		val    interface{}
+		want   string
+	}{
+		{"%s", NewString("foo", true), "foo"},
+		{"%q", NewString("foo", true), `"foo"`},
+		{"[%5s]", NewString("foo", true), "[  foo]"},
+		{"%05d", NewInt(42, true), "00042"},
+		{"%x", NewInt(255, true), "ff"},
+		{"%.2f", NewFloat64(1.005, true), "1.00"},
+		{"%s", NewBytes([]byte("foo"), true), "foo"},
+		{"%s", NewInt(3, true), "3"},
+		{"%s", NewBool(true, true), "true"},
+		{"%s", NewFloat64(1.5, true), "1.5"},
+		{"[%4s]", NewInt64(42, true), "[  42]"},
+		{"%s", NewValue(7, true), "7"},
+		{"%d", NewInt(0, false), "<null>"},
+		{"[%-8v]", NewInt(0, false), "[<null>  ]"},
+	}
+	for _, tt := range tests {
+		got := fmt.Sprintf(tt.format, tt.val)
+		if got != tt.want {
+			t.Fatalf("want %v, but %v:", tt.want, got)
+		}
+	}
+}
+
+func TestFormatGoSyntax(t *testing.T) {
+	tests := []struct {
+		val  interface{}
+		want string
+	}{
+		{NewString("foo", true), `null.String{String:"foo", Valid:true}`},
+		{NewString("", false), `null.String{String:"", Valid:false}`},
+		{NewInt64(1, true), `null.Int64{Int64:1, Valid:true}`},
+		{NewFloat32(1.5, true), `null.Float32{Float32:1.5, Valid:true}`},
+		{NewValue("bar", true), `null.Value[string]{V:"bar", Valid:true}`},
+		{NewOptional(1, false), `null.Optional[int]{V:1, Valid:false, Set:true}`},
+		{NewRedactedString("foo", true), `null.RedactedString{String:null.String{String:"foo", Valid:true}}`},
+	}
+	for _, tt := range tests {
+		got := fmt.Sprintf("%#v", tt.val)
+		if got != tt.want {
+			t.Fatalf("want %v, but %v:", tt.want, got)
+		}
+	}
+}
+
+func TestFormatNullPlaceholder(t *testing.T) {
+	defer func(p string) { NullPlaceholder = p }(NullPlaceholder)
+	NullPlaceholder = "NULL"
+
+	got := fmt.Sprintf("%v %s", NewInt(0, false), NewString("", false))
+	want := "NULL NULL"
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestStringer(t *testing.T) {
+	tests := []struct {
+		val  fmt.Stringer
+		want string
+	}{
+		{NewInt(1, true), "1"},
+		{NewUint64(18446744073709551615, true), "18446744073709551615"},
+		{NewBool(false, false), "<null>"},
+		{NewValue(1.5, true), "1.5"},
+	}
+	for _, tt := range tests {
+		got := tt.val.String()
+		if got != tt.want {
+			t.Fatalf("want %v, but %v:", tt.want, got)
+		}
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/r-fujiyama/null
 
-go 1.19
+go 1.21
@@ -2,9 +2,9 @@ package null
 
 import (
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/xml"
 	"fmt"
-	"strconv"
+	"log/slog"
 )
 
 // Int represents a int that may be null.
@@ -18,80 +18,138 @@ func NewInt(i int, valid bool) Int {
 	return Int{Int: i, Valid: valid}
 }
 
-// Scan implements the Scanner interface.
-func (i *Int) Scan(value interface{}) error {
-	if value == nil {
-		i.Int, i.Valid = 0, false
-		return nil
+// IntFrom creates a new Int that is always valid.
+func IntFrom(i int) Int {
+	return NewInt(i, true)
+}
+
+// IntFromPtr creates a new Int that is null if i is nil.
+func IntFromPtr(i *int) Int {
+	if i == nil {
+		return Int{}
 	}
+	return NewInt(*i, true)
+}
 
-	i.Valid = true
-	switch data := value.(type) {
-	case string:
-		integer, err := strconv.Atoi(data)
-		if err != nil {
-			return err
-		}
-		i.Int = integer
-		return nil
-	case []byte:
-		integer, err := strconv.Atoi(string(data))
-		if err != nil {
-			return err
-		}
-		i.Int = integer
-		return nil
-	case int:
-		i.Int = data
-		return nil
-	case int8:
-		i.Int = int(data)
-		return nil
-	case int16:
-		i.Int = int(data)
-		return nil
-	case int32:
-		i.Int = int(data)
-		return nil
-	case int64:
-		i.Int = int(data)
-		return nil
-	default:
-		return fmt.Errorf("unsupported type: %T", value)
+// Scan implements the Scanner interface.
+// Numbers of any integer type and floats with no fractional part are accepted,
+// and a number out of the range of Int is reported as an *OverflowError.
+func (i *Int) Scan(value interface{}) error {
+	n := Value[int]{}
+	if err := n.scan("Int", value); err != nil {
+		return err
 	}
+	i.Int, i.Valid = n.V, n.Valid
+	return nil
 }
 
 // Value implements the driver Valuer interface.
 func (i Int) Value() (driver.Value, error) {
-	if !i.Valid {
-		return nil, nil
-	}
-	return int64(i.Int), nil
+	return Value[int]{V: i.Int, Valid: i.Valid}.Value()
 }
 
 // MarshalJSON encode the value to JSON.
 func (i Int) MarshalJSON() ([]byte, error) {
-	if !i.Valid {
-		return []byte("null"), nil
-	}
-	return jsonMarshal(i.Int)
+	return Value[int]{V: i.Int, Valid: i.Valid}.MarshalJSON()
 }
 
 // UnmarshalJSON decode data to the value.
+// A quoted value is accepted as well if LenientJSON is true.
 func (i *Int) UnmarshalJSON(data []byte) error {
-	var integer *int
-	if err := json.Unmarshal(data, &integer); err != nil {
+	n := Value[int]{}
+	if err := n.unmarshalJSON("Int", data); err != nil {
 		return err
 	}
-	i.Valid = integer != nil
-	if i.Valid {
-		i.Int = *integer
-	} else {
-		i.Int = 0
+	i.Int, i.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (i Int) MarshalText() ([]byte, error) {
+	return Value[int]{V: i.Int, Valid: i.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (i *Int) UnmarshalText(text []byte) error {
+	n := Value[int]{}
+	if err := n.unmarshalText("Int", text); err != nil {
+		return err
+	}
+	i.Int, i.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (i Int) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i.Valid, i)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (i *Int) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, i)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (i Int) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i.Valid, i)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (i *Int) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (i Int) MarshalYAML() (interface{}, error) {
+	return Value[int]{V: i.Int, Valid: i.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (i *Int) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[int]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
 	}
+	i.Int, i.Valid = n.V, n.Valid
 	return nil
 }
 
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (i Int) LogValue() slog.Value {
+	return Value[int]{V: i.Int, Valid: i.Valid}.LogValue()
+}
+
+// Format implements the fmt.Formatter interface.
+func (i Int) Format(state fmt.State, verb rune) {
+	format(state, verb, i, i.Valid, i.Int)
+}
+
+// String implements the fmt.Stringer interface.
+func (i Int) String() string {
+	return fmt.Sprint(i)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (i Int) Ptr() *int {
+	return Value[int]{V: i.Int, Valid: i.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (i Int) ValueOrZero() int {
+	return Value[int]{V: i.Int, Valid: i.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (i Int) ValueOr(v int) int {
+	return Value[int]{V: i.Int, Valid: i.Valid}.ValueOr(v)
+}
+
 // IsNull returns true if Valid is false.
 func (i *Int) IsNull() bool {
 	return !i.Valid
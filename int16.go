@@ -1,11 +1,11 @@
 package null
 
 import (
+	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/xml"
 	"fmt"
-	"math"
-	"strconv"
+	"log/slog"
 )
 
 // Int16 represents a int16 that may be null.
@@ -19,77 +19,148 @@ func NewInt16(i16 int16, valid bool) Int16 {
 	return Int16{Int16: i16, Valid: valid}
 }
 
-// Scan implements the Scanner interface.
-func (i *Int16) Scan(value interface{}) error {
-	if value == nil {
-		i.Int16, i.Valid = 0, false
-		return nil
+// Int16From creates a new Int16 that is always valid.
+func Int16From(i int16) Int16 {
+	return NewInt16(i, true)
+}
+
+// Int16FromPtr creates a new Int16 that is null if i is nil.
+func Int16FromPtr(i *int16) Int16 {
+	if i == nil {
+		return Int16{}
 	}
+	return NewInt16(*i, true)
+}
+
+// Int16FromSQL creates a new Int16 from a sql.NullInt16.
+func Int16FromSQL(i sql.NullInt16) Int16 {
+	return NewInt16(i.Int16, i.Valid)
+}
 
-	i.Valid = true
-	switch data := value.(type) {
-	case string:
-		i16, err := strconv.ParseInt(data, 10, 16)
-		if err != nil {
-			return err
-		}
-		i.Int16 = int16(i16)
-		return nil
-	case []byte:
-		i16, err := strconv.ParseInt(string(data), 10, 16)
-		if err != nil {
-			return err
-		}
-		i.Int16 = int16(i16)
-		return nil
-	case int:
-		if data > math.MaxInt16 || data < math.MinInt16 {
-			return fmt.Errorf("maximum or minimum value of Int16 exceeded: %d", data)
-		}
-		i.Int16 = int16(data)
-		return nil
-	case int8:
-		i.Int16 = int16(data)
-		return nil
-	case int16:
-		i.Int16 = int16(data)
-		return nil
-	default:
-		return fmt.Errorf("unsupported type: %T", value)
+// Scan implements the Scanner interface.
+// Numbers of any integer type and floats with no fractional part are accepted,
+// and a number out of the range of Int16 is reported as an *OverflowError.
+func (i *Int16) Scan(value interface{}) error {
+	n := Value[int16]{}
+	if err := n.scan("Int16", value); err != nil {
+		return err
 	}
+	i.Int16, i.Valid = n.V, n.Valid
+	return nil
 }
 
 // Value implements the driver Valuer interface.
 func (i Int16) Value() (driver.Value, error) {
-	if !i.Valid {
-		return nil, nil
-	}
-	return int64(i.Int16), nil
+	return Value[int16]{V: i.Int16, Valid: i.Valid}.Value()
 }
 
 // MarshalJSON encode the value to JSON.
 func (i Int16) MarshalJSON() ([]byte, error) {
-	if !i.Valid {
-		return []byte("null"), nil
-	}
-	return jsonMarshal(i.Int16)
+	return Value[int16]{V: i.Int16, Valid: i.Valid}.MarshalJSON()
 }
 
 // UnmarshalJSON decode data to the value.
+// A quoted value is accepted as well if LenientJSON is true.
 func (i *Int16) UnmarshalJSON(data []byte) error {
-	var i16 *int16
-	if err := json.Unmarshal(data, &i16); err != nil {
+	n := Value[int16]{}
+	if err := n.unmarshalJSON("Int16", data); err != nil {
+		return err
+	}
+	i.Int16, i.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (i Int16) MarshalText() ([]byte, error) {
+	return Value[int16]{V: i.Int16, Valid: i.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (i *Int16) UnmarshalText(text []byte) error {
+	n := Value[int16]{}
+	if err := n.unmarshalText("Int16", text); err != nil {
 		return err
 	}
-	i.Valid = i16 != nil
-	if i.Valid {
-		i.Int16 = *i16
-	} else {
-		i.Int16 = 0
+	i.Int16, i.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (i Int16) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i.Valid, i)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (i *Int16) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, i)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (i Int16) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i.Valid, i)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (i *Int16) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (i Int16) MarshalYAML() (interface{}, error) {
+	return Value[int16]{V: i.Int16, Valid: i.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (i *Int16) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[int16]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
 	}
+	i.Int16, i.Valid = n.V, n.Valid
 	return nil
 }
 
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (i Int16) LogValue() slog.Value {
+	return Value[int16]{V: i.Int16, Valid: i.Valid}.LogValue()
+}
+
+// Format implements the fmt.Formatter interface.
+func (i Int16) Format(state fmt.State, verb rune) {
+	format(state, verb, i, i.Valid, i.Int16)
+}
+
+// String implements the fmt.Stringer interface.
+func (i Int16) String() string {
+	return fmt.Sprint(i)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (i Int16) Ptr() *int16 {
+	return Value[int16]{V: i.Int16, Valid: i.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (i Int16) ValueOrZero() int16 {
+	return Value[int16]{V: i.Int16, Valid: i.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (i Int16) ValueOr(v int16) int16 {
+	return Value[int16]{V: i.Int16, Valid: i.Valid}.ValueOr(v)
+}
+
+// ToSQL returns the value as a sql.NullInt16.
+func (i Int16) ToSQL() sql.NullInt16 {
+	return sql.NullInt16{Int16: i.Int16, Valid: i.Valid}
+}
+
 // IsNull returns true if Valid is false.
 func (i *Int16) IsNull() bool {
 	return !i.Valid
@@ -2,7 +2,9 @@ package null
 
 import (
 	"bytes"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"math"
 	"strings"
 	"testing"
@@ -61,8 +63,8 @@ func TestInt16ScanMaximumValueOver(t *testing.T) {
 	val := Int16{}
 	var i int = math.MaxInt16 + 1
 	err := val.Scan(i)
-	if err == nil || err.Error() != "maximum or minimum value of Int16 exceeded: 32768" {
-		t.Fatalf("want %v, but %v:", "maximum or minimum value of Int16 exceeded: 32768", err)
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
 	}
 }
 
@@ -70,8 +72,8 @@ func TestInt16ScanMinimumValueOver(t *testing.T) {
 	val := Int16{}
 	var i int = math.MinInt16 - 1
 	err := val.Scan(i)
-	if err == nil || err.Error() != "maximum or minimum value of Int16 exceeded: -32769" {
-		t.Fatalf("want %v, but %v:", "maximum or minimum value of Int16 exceeded: -32769", err)
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
 	}
 }
 
@@ -120,8 +122,8 @@ func TestInt16ScanStringByteError(t *testing.T) {
 func TestInt16ScanTypeError(t *testing.T) {
 	val := Int16{}
 	err := val.Scan(struct{}{})
-	if err == nil || err.Error() != "unsupported type: struct {}" {
-		t.Fatalf("want %v, but %v:", "unsupported type: struct {}", err)
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
 	}
 }
 
@@ -238,6 +240,63 @@ func TestInt16UnmarshalJSONError(t *testing.T) {
 	}
 }
 
+func TestInt16MarshalText(t *testing.T) {
+	val := NewInt16(-1, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `-1`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestInt16MarshalTextNull(t *testing.T) {
+	val := NewInt16(1, false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestInt16UnmarshalText(t *testing.T) {
+	var val Int16
+	if err := val.UnmarshalText([]byte(`-1`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewInt16(-1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt16UnmarshalTextEmpty(t *testing.T) {
+	val := NewInt16(1, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewInt16(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt16UnmarshalTextError(t *testing.T) {
+	val := Int16{}
+	err := val.UnmarshalText([]byte("32768"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
 func TestInt16IsNull(t *testing.T) {
 	val := NewInt16(0, true)
 	if val.IsNull() {
@@ -249,3 +308,81 @@ func TestInt16IsNull(t *testing.T) {
 		t.Fatal("it has to be not null")
 	}
 }
+
+func TestInt16From(t *testing.T) {
+	val := Int16From(int16(1))
+	want := NewInt16(int16(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt16FromPtr(t *testing.T) {
+	v := int16(1)
+	val := Int16FromPtr(&v)
+	want := NewInt16(int16(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = Int16FromPtr(nil)
+	want = Int16{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt16Ptr(t *testing.T) {
+	val := NewInt16(int16(1), true)
+	got := val.Ptr()
+	if got == nil || *got != int16(1) {
+		t.Fatalf("want %v, but %v:", int16(1), got)
+	}
+
+	val = NewInt16(int16(1), false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestInt16ValueOr(t *testing.T) {
+	val := NewInt16(int16(1), true)
+	if got := val.ValueOrZero(); got != int16(1) {
+		t.Fatalf("want %v, but %v:", int16(1), got)
+	}
+	if got := val.ValueOr(int16(2)); got != int16(1) {
+		t.Fatalf("want %v, but %v:", int16(1), got)
+	}
+
+	val = NewInt16(int16(1), false)
+	if got := val.ValueOrZero(); got != int16(0) {
+		t.Fatalf("want %v, but %v:", int16(0), got)
+	}
+	if got := val.ValueOr(int16(2)); got != int16(2) {
+		t.Fatalf("want %v, but %v:", int16(2), got)
+	}
+}
+
+func TestInt16FromSQL(t *testing.T) {
+	val := Int16FromSQL(sql.NullInt16{Int16: 1, Valid: true})
+	want := NewInt16(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = Int16FromSQL(sql.NullInt16{Int16: 1, Valid: false})
+	want = NewInt16(1, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt16ToSQL(t *testing.T) {
+	for _, valid := range []bool{true, false} {
+		got := NewInt16(1, valid).ToSQL()
+		want := sql.NullInt16{Int16: 1, Valid: valid}
+		if got != want {
+			t.Fatalf("want %v, but %v:", want, got)
+		}
+	}
+}
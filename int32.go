@@ -1,11 +1,11 @@
 package null
 
 import (
+	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/xml"
 	"fmt"
-	"math"
-	"strconv"
+	"log/slog"
 )
 
 // Int32 represents a int32 that may be null.
@@ -19,80 +19,148 @@ func NewInt32(i32 int32, valid bool) Int32 {
 	return Int32{Int32: i32, Valid: valid}
 }
 
-// Scan implements the Scanner interface.
-func (i *Int32) Scan(value interface{}) error {
-	if value == nil {
-		i.Int32, i.Valid = 0, false
-		return nil
+// Int32From creates a new Int32 that is always valid.
+func Int32From(i int32) Int32 {
+	return NewInt32(i, true)
+}
+
+// Int32FromPtr creates a new Int32 that is null if i is nil.
+func Int32FromPtr(i *int32) Int32 {
+	if i == nil {
+		return Int32{}
 	}
+	return NewInt32(*i, true)
+}
+
+// Int32FromSQL creates a new Int32 from a sql.NullInt32.
+func Int32FromSQL(i sql.NullInt32) Int32 {
+	return NewInt32(i.Int32, i.Valid)
+}
 
-	i.Valid = true
-	switch data := value.(type) {
-	case string:
-		i32, err := strconv.ParseInt(data, 10, 32)
-		if err != nil {
-			return err
-		}
-		i.Int32 = int32(i32)
-		return nil
-	case []byte:
-		i32, err := strconv.ParseInt(string(data), 10, 32)
-		if err != nil {
-			return err
-		}
-		i.Int32 = int32(i32)
-		return nil
-	case int:
-		if data > math.MaxInt32 || data < math.MinInt32 {
-			return fmt.Errorf("maximum or minimum value of Int32 exceeded: %d", data)
-		}
-		i.Int32 = int32(data)
-		return nil
-	case int8:
-		i.Int32 = int32(data)
-		return nil
-	case int16:
-		i.Int32 = int32(data)
-		return nil
-	case int32:
-		i.Int32 = data
-		return nil
-	default:
-		return fmt.Errorf("unsupported type: %T", value)
+// Scan implements the Scanner interface.
+// Numbers of any integer type and floats with no fractional part are accepted,
+// and a number out of the range of Int32 is reported as an *OverflowError.
+func (i *Int32) Scan(value interface{}) error {
+	n := Value[int32]{}
+	if err := n.scan("Int32", value); err != nil {
+		return err
 	}
+	i.Int32, i.Valid = n.V, n.Valid
+	return nil
 }
 
 // Value implements the driver Valuer interface.
 func (i Int32) Value() (driver.Value, error) {
-	if !i.Valid {
-		return nil, nil
-	}
-	return int64(i.Int32), nil
+	return Value[int32]{V: i.Int32, Valid: i.Valid}.Value()
 }
 
 // MarshalJSON encode the value to JSON.
 func (i Int32) MarshalJSON() ([]byte, error) {
-	if !i.Valid {
-		return []byte("null"), nil
-	}
-	return jsonMarshal(i.Int32)
+	return Value[int32]{V: i.Int32, Valid: i.Valid}.MarshalJSON()
 }
 
 // UnmarshalJSON decode data to the value.
+// A quoted value is accepted as well if LenientJSON is true.
 func (i *Int32) UnmarshalJSON(data []byte) error {
-	var i32 *int32
-	if err := json.Unmarshal(data, &i32); err != nil {
+	n := Value[int32]{}
+	if err := n.unmarshalJSON("Int32", data); err != nil {
+		return err
+	}
+	i.Int32, i.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (i Int32) MarshalText() ([]byte, error) {
+	return Value[int32]{V: i.Int32, Valid: i.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (i *Int32) UnmarshalText(text []byte) error {
+	n := Value[int32]{}
+	if err := n.unmarshalText("Int32", text); err != nil {
 		return err
 	}
-	i.Valid = i32 != nil
-	if i.Valid {
-		i.Int32 = *i32
-	} else {
-		i.Int32 = 0
+	i.Int32, i.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (i Int32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i.Valid, i)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (i *Int32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, i)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (i Int32) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i.Valid, i)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (i *Int32) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (i Int32) MarshalYAML() (interface{}, error) {
+	return Value[int32]{V: i.Int32, Valid: i.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (i *Int32) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[int32]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
 	}
+	i.Int32, i.Valid = n.V, n.Valid
 	return nil
 }
 
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (i Int32) LogValue() slog.Value {
+	return Value[int32]{V: i.Int32, Valid: i.Valid}.LogValue()
+}
+
+// Format implements the fmt.Formatter interface.
+func (i Int32) Format(state fmt.State, verb rune) {
+	format(state, verb, i, i.Valid, i.Int32)
+}
+
+// String implements the fmt.Stringer interface.
+func (i Int32) String() string {
+	return fmt.Sprint(i)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (i Int32) Ptr() *int32 {
+	return Value[int32]{V: i.Int32, Valid: i.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (i Int32) ValueOrZero() int32 {
+	return Value[int32]{V: i.Int32, Valid: i.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (i Int32) ValueOr(v int32) int32 {
+	return Value[int32]{V: i.Int32, Valid: i.Valid}.ValueOr(v)
+}
+
+// ToSQL returns the value as a sql.NullInt32.
+func (i Int32) ToSQL() sql.NullInt32 {
+	return sql.NullInt32{Int32: i.Int32, Valid: i.Valid}
+}
+
 // IsNull returns true if Valid is false.
 func (i *Int32) IsNull() bool {
 	return !i.Valid
@@ -2,7 +2,9 @@ package null
 
 import (
 	"bytes"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"math"
 	"strings"
 	"testing"
@@ -59,19 +61,19 @@ func TestInt32ScanInt(t *testing.T) {
 
 func TestInt32ScanMaximumValueOver(t *testing.T) {
 	val := Int32{}
-	var i int = math.MaxInt32 + 1
+	var i int64 = math.MaxInt32 + 1
 	err := val.Scan(i)
-	if err == nil || err.Error() != "maximum or minimum value of Int32 exceeded: 2147483648" {
-		t.Fatalf("want %v, but %v:", "maximum or minimum value of Int32 exceeded: 2147483648", err)
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
 	}
 }
 
 func TestInt32ScanMinimumValueOver(t *testing.T) {
 	val := Int32{}
-	var i int = math.MinInt32 - 1
+	var i int64 = math.MinInt32 - 1
 	err := val.Scan(i)
-	if err == nil || err.Error() != "maximum or minimum value of Int32 exceeded: -2147483649" {
-		t.Fatalf("want %v, but %v:", "maximum or minimum value of Int32 exceeded: -2147483649", err)
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
 	}
 }
 
@@ -133,8 +135,8 @@ func TestInt32ScanStringByteError(t *testing.T) {
 func TestInt32ScanTypeError(t *testing.T) {
 	val := Int32{}
 	err := val.Scan(struct{}{})
-	if err == nil || err.Error() != "unsupported type: struct {}" {
-		t.Fatalf("want %v, but %v:", "unsupported type: struct {}", err)
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
 	}
 }
 
@@ -251,6 +253,63 @@ func TestInt32UnmarshalJSONError(t *testing.T) {
 	}
 }
 
+func TestInt32MarshalText(t *testing.T) {
+	val := NewInt32(-1, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `-1`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestInt32MarshalTextNull(t *testing.T) {
+	val := NewInt32(1, false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestInt32UnmarshalText(t *testing.T) {
+	var val Int32
+	if err := val.UnmarshalText([]byte(`-1`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewInt32(-1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt32UnmarshalTextEmpty(t *testing.T) {
+	val := NewInt32(1, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewInt32(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt32UnmarshalTextError(t *testing.T) {
+	val := Int32{}
+	err := val.UnmarshalText([]byte("foo"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
 func TestInt32IsNull(t *testing.T) {
 	val := NewInt32(0, true)
 	if val.IsNull() {
@@ -262,3 +321,81 @@ func TestInt32IsNull(t *testing.T) {
 		t.Fatal("it has to be not null")
 	}
 }
+
+func TestInt32From(t *testing.T) {
+	val := Int32From(int32(1))
+	want := NewInt32(int32(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt32FromPtr(t *testing.T) {
+	v := int32(1)
+	val := Int32FromPtr(&v)
+	want := NewInt32(int32(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = Int32FromPtr(nil)
+	want = Int32{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt32Ptr(t *testing.T) {
+	val := NewInt32(int32(1), true)
+	got := val.Ptr()
+	if got == nil || *got != int32(1) {
+		t.Fatalf("want %v, but %v:", int32(1), got)
+	}
+
+	val = NewInt32(int32(1), false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestInt32ValueOr(t *testing.T) {
+	val := NewInt32(int32(1), true)
+	if got := val.ValueOrZero(); got != int32(1) {
+		t.Fatalf("want %v, but %v:", int32(1), got)
+	}
+	if got := val.ValueOr(int32(2)); got != int32(1) {
+		t.Fatalf("want %v, but %v:", int32(1), got)
+	}
+
+	val = NewInt32(int32(1), false)
+	if got := val.ValueOrZero(); got != int32(0) {
+		t.Fatalf("want %v, but %v:", int32(0), got)
+	}
+	if got := val.ValueOr(int32(2)); got != int32(2) {
+		t.Fatalf("want %v, but %v:", int32(2), got)
+	}
+}
+
+func TestInt32FromSQL(t *testing.T) {
+	val := Int32FromSQL(sql.NullInt32{Int32: 1, Valid: true})
+	want := NewInt32(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = Int32FromSQL(sql.NullInt32{Int32: 1, Valid: false})
+	want = NewInt32(1, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt32ToSQL(t *testing.T) {
+	for _, valid := range []bool{true, false} {
+		got := NewInt32(1, valid).ToSQL()
+		want := sql.NullInt32{Int32: 1, Valid: valid}
+		if got != want {
+			t.Fatalf("want %v, but %v:", want, got)
+		}
+	}
+}
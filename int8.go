@@ -2,10 +2,9 @@ package null
 
 import (
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/xml"
 	"fmt"
-	"math"
-	"strconv"
+	"log/slog"
 )
 
 // Int8 represents a int8 that may be null.
@@ -19,74 +18,138 @@ func NewInt8(i8 int8, valid bool) Int8 {
 	return Int8{Int8: i8, Valid: valid}
 }
 
-// Scan implements the Scanner interface.
-func (i *Int8) Scan(value interface{}) error {
-	if value == nil {
-		i.Int8, i.Valid = 0, false
-		return nil
+// Int8From creates a new Int8 that is always valid.
+func Int8From(i int8) Int8 {
+	return NewInt8(i, true)
+}
+
+// Int8FromPtr creates a new Int8 that is null if i is nil.
+func Int8FromPtr(i *int8) Int8 {
+	if i == nil {
+		return Int8{}
 	}
+	return NewInt8(*i, true)
+}
 
-	i.Valid = true
-	switch data := value.(type) {
-	case string:
-		i8, err := strconv.ParseInt(data, 10, 8)
-		if err != nil {
-			return err
-		}
-		i.Int8 = int8(i8)
-		return nil
-	case []byte:
-		i8, err := strconv.ParseInt(string(data), 10, 8)
-		if err != nil {
-			return err
-		}
-		i.Int8 = int8(i8)
-		return nil
-	case int:
-		if data > math.MaxInt8 || data < math.MinInt8 {
-			return fmt.Errorf("maximum or minimum value of Int16 exceeded: %d", data)
-		}
-		i.Int8 = int8(data)
-		return nil
-	case int8:
-		i.Int8 = int8(data)
-		return nil
-	default:
-		return fmt.Errorf("unsupported type: %T", value)
+// Scan implements the Scanner interface.
+// Numbers of any integer type and floats with no fractional part are accepted,
+// and a number out of the range of Int8 is reported as an *OverflowError.
+func (i *Int8) Scan(value interface{}) error {
+	n := Value[int8]{}
+	if err := n.scan("Int8", value); err != nil {
+		return err
 	}
+	i.Int8, i.Valid = n.V, n.Valid
+	return nil
 }
 
 // Value implements the driver Valuer interface.
 func (i Int8) Value() (driver.Value, error) {
-	if !i.Valid {
-		return nil, nil
-	}
-	return int64(i.Int8), nil
+	return Value[int8]{V: i.Int8, Valid: i.Valid}.Value()
 }
 
 // MarshalJSON encode the value to JSON.
 func (i Int8) MarshalJSON() ([]byte, error) {
-	if !i.Valid {
-		return []byte("null"), nil
-	}
-	return jsonMarshal(i.Int8)
+	return Value[int8]{V: i.Int8, Valid: i.Valid}.MarshalJSON()
 }
 
 // UnmarshalJSON decode data to the value.
+// A quoted value is accepted as well if LenientJSON is true.
 func (i *Int8) UnmarshalJSON(data []byte) error {
-	var i8 *int8
-	if err := json.Unmarshal(data, &i8); err != nil {
+	n := Value[int8]{}
+	if err := n.unmarshalJSON("Int8", data); err != nil {
 		return err
 	}
-	i.Valid = i8 != nil
-	if i.Valid {
-		i.Int8 = *i8
-	} else {
-		i.Int8 = 0
+	i.Int8, i.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (i Int8) MarshalText() ([]byte, error) {
+	return Value[int8]{V: i.Int8, Valid: i.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (i *Int8) UnmarshalText(text []byte) error {
+	n := Value[int8]{}
+	if err := n.unmarshalText("Int8", text); err != nil {
+		return err
+	}
+	i.Int8, i.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (i Int8) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i.Valid, i)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (i *Int8) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, i)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (i Int8) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i.Valid, i)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (i *Int8) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (i Int8) MarshalYAML() (interface{}, error) {
+	return Value[int8]{V: i.Int8, Valid: i.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (i *Int8) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[int8]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
 	}
+	i.Int8, i.Valid = n.V, n.Valid
 	return nil
 }
 
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (i Int8) LogValue() slog.Value {
+	return Value[int8]{V: i.Int8, Valid: i.Valid}.LogValue()
+}
+
+// Format implements the fmt.Formatter interface.
+func (i Int8) Format(state fmt.State, verb rune) {
+	format(state, verb, i, i.Valid, i.Int8)
+}
+
+// String implements the fmt.Stringer interface.
+func (i Int8) String() string {
+	return fmt.Sprint(i)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (i Int8) Ptr() *int8 {
+	return Value[int8]{V: i.Int8, Valid: i.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (i Int8) ValueOrZero() int8 {
+	return Value[int8]{V: i.Int8, Valid: i.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (i Int8) ValueOr(v int8) int8 {
+	return Value[int8]{V: i.Int8, Valid: i.Valid}.ValueOr(v)
+}
+
 // IsNull returns true if Valid is false.
 func (i *Int8) IsNull() bool {
 	return !i.Valid
@@ -3,6 +3,7 @@ package null
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"math"
 	"strings"
 	"testing"
@@ -61,8 +62,8 @@ func TestInt8ScanMaximumValueOver(t *testing.T) {
 	val := Int8{}
 	var i int = math.MaxInt8 + 1
 	err := val.Scan(i)
-	if err == nil || err.Error() != "maximum or minimum value of Int16 exceeded: 128" {
-		t.Fatalf("want %v, but %v:", "maximum or minimum value of Int16 exceeded: 128", err)
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
 	}
 }
 
@@ -70,8 +71,8 @@ func TestInt8ScanMinimumValueOver(t *testing.T) {
 	val := Int8{}
 	var i int = math.MinInt8 - 1
 	err := val.Scan(i)
-	if err == nil || err.Error() != "maximum or minimum value of Int16 exceeded: -129" {
-		t.Fatalf("want %v, but %v:", "maximum or minimum value of Int16 exceeded: -129", err)
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
 	}
 }
 
@@ -107,8 +108,8 @@ func TestInt8ScanStringByteError(t *testing.T) {
 func TestInt8ScanTypeError(t *testing.T) {
 	val := Int8{}
 	err := val.Scan(struct{}{})
-	if err == nil || err.Error() != "unsupported type: struct {}" {
-		t.Fatalf("want %v, but %v:", "unsupported type: struct {}", err)
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
 	}
 }
 
@@ -225,6 +226,63 @@ func TestInt8UnmarshalJSONError(t *testing.T) {
 	}
 }
 
+func TestInt8MarshalText(t *testing.T) {
+	val := NewInt8(-1, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `-1`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestInt8MarshalTextNull(t *testing.T) {
+	val := NewInt8(1, false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestInt8UnmarshalText(t *testing.T) {
+	var val Int8
+	if err := val.UnmarshalText([]byte(`-1`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewInt8(-1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt8UnmarshalTextEmpty(t *testing.T) {
+	val := NewInt8(1, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewInt8(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt8UnmarshalTextError(t *testing.T) {
+	val := Int8{}
+	err := val.UnmarshalText([]byte("128"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
 func TestInt8IsNull(t *testing.T) {
 	val := NewInt8(0, true)
 	if val.IsNull() {
@@ -236,3 +294,57 @@ func TestInt8IsNull(t *testing.T) {
 		t.Fatal("it has to be not null")
 	}
 }
+
+func TestInt8From(t *testing.T) {
+	val := Int8From(int8(1))
+	want := NewInt8(int8(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt8FromPtr(t *testing.T) {
+	v := int8(1)
+	val := Int8FromPtr(&v)
+	want := NewInt8(int8(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = Int8FromPtr(nil)
+	want = Int8{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestInt8Ptr(t *testing.T) {
+	val := NewInt8(int8(1), true)
+	got := val.Ptr()
+	if got == nil || *got != int8(1) {
+		t.Fatalf("want %v, but %v:", int8(1), got)
+	}
+
+	val = NewInt8(int8(1), false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestInt8ValueOr(t *testing.T) {
+	val := NewInt8(int8(1), true)
+	if got := val.ValueOrZero(); got != int8(1) {
+		t.Fatalf("want %v, but %v:", int8(1), got)
+	}
+	if got := val.ValueOr(int8(2)); got != int8(1) {
+		t.Fatalf("want %v, but %v:", int8(1), got)
+	}
+
+	val = NewInt8(int8(1), false)
+	if got := val.ValueOrZero(); got != int8(0) {
+		t.Fatalf("want %v, but %v:", int8(0), got)
+	}
+	if got := val.ValueOr(int8(2)); got != int8(2) {
+		t.Fatalf("want %v, but %v:", int8(2), got)
+	}
+}
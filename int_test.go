@@ -3,6 +3,11 @@ package null
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"flag"
+	"math"
+	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -108,6 +113,24 @@ func TestIntScanInt64(t *testing.T) {
 	}
 }
 
+func TestIntScanInt64Overflow(t *testing.T) {
+	for _, value := range []interface{}{int64(math.MaxInt32 + 1), "2147483648", int64(math.MinInt32 - 1)} {
+		val := Int{}
+		err := val.Scan(value)
+		if strconv.IntSize == 64 {
+			if err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+
+		var overflow *OverflowError
+		if !errors.As(err, &overflow) || overflow.Target != "Int" || !errors.Is(err, ErrOutOfRange) {
+			t.Fatalf("want an *OverflowError, but %v:", err)
+		}
+	}
+}
+
 func TestIntScanStringParseError(t *testing.T) {
 	val := Int{}
 	err := val.Scan("foo")
@@ -127,8 +150,8 @@ func TestIntScanStringByteError(t *testing.T) {
 func TestIntScanTypeError(t *testing.T) {
 	val := Int{}
 	err := val.Scan(struct{}{})
-	if err == nil || err.Error() != "unsupported type: struct {}" {
-		t.Fatalf("want %v, but %v:", "unsupported type: struct {}", err)
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
 	}
 }
 
@@ -245,6 +268,89 @@ func TestIntUnmarshalJSONError(t *testing.T) {
 	}
 }
 
+func TestIntMarshalText(t *testing.T) {
+	val := NewInt(-1, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `-1`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestIntMarshalTextNull(t *testing.T) {
+	val := NewInt(1, false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestIntUnmarshalText(t *testing.T) {
+	var val Int
+	if err := val.UnmarshalText([]byte(`-1`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewInt(-1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestIntUnmarshalTextEmpty(t *testing.T) {
+	val := NewInt(1, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewInt(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestIntUnmarshalTextError(t *testing.T) {
+	val := Int{}
+	err := val.UnmarshalText([]byte("foo"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestIntJSONMapKey(t *testing.T) {
+	val := map[Int]string{}
+	if err := json.Unmarshal([]byte(`{"1":"foo","":"bar"}`), &val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[Int]string{NewInt(1, true): "foo", NewInt(0, false): "bar"}
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestIntFlagTextVar(t *testing.T) {
+	var val Int
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.TextVar(&val, "n", NewInt(0, false), "usage")
+	if err := fs.Parse([]string{"-n", "42"}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewInt(42, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
 func TestIntIsNull(t *testing.T) {
 	val := NewInt(0, true)
 	if val.IsNull() {
@@ -256,3 +362,57 @@ func TestIntIsNull(t *testing.T) {
 		t.Fatal("it has to be not null")
 	}
 }
+
+func TestIntFrom(t *testing.T) {
+	val := IntFrom(1)
+	want := NewInt(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestIntFromPtr(t *testing.T) {
+	v := 1
+	val := IntFromPtr(&v)
+	want := NewInt(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = IntFromPtr(nil)
+	want = Int{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestIntPtr(t *testing.T) {
+	val := NewInt(1, true)
+	got := val.Ptr()
+	if got == nil || *got != 1 {
+		t.Fatalf("want %v, but %v:", 1, got)
+	}
+
+	val = NewInt(1, false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestIntValueOr(t *testing.T) {
+	val := NewInt(1, true)
+	if got := val.ValueOrZero(); got != 1 {
+		t.Fatalf("want %v, but %v:", 1, got)
+	}
+	if got := val.ValueOr(2); got != 1 {
+		t.Fatalf("want %v, but %v:", 1, got)
+	}
+
+	val = NewInt(1, false)
+	if got := val.ValueOrZero(); got != 0 {
+		t.Fatalf("want %v, but %v:", 0, got)
+	}
+	if got := val.ValueOr(2); got != 2 {
+		t.Fatalf("want %v, but %v:", 2, got)
+	}
+}
@@ -0,0 +1,48 @@
+package null
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// Interval represents a time.Duration that may be null and is passed to the driver as
+// an interval string in the form "-01:30:00.5", e.g. for a Postgres interval column.
+// It behaves like Duration in every other respect.
+type Interval struct {
+	Duration
+}
+
+// NewInterval creates a new Interval
+func NewInterval(d time.Duration, valid bool) Interval {
+	return Interval{Duration: NewDuration(d, valid)}
+}
+
+// IntervalFrom creates a new Interval that is always valid.
+func IntervalFrom(d time.Duration) Interval {
+	return NewInterval(d, true)
+}
+
+// IntervalFromPtr creates a new Interval that is null if d is nil.
+func IntervalFromPtr(d *time.Duration) Interval {
+	return Interval{Duration: DurationFromPtr(d)}
+}
+
+// Value implements the driver Valuer interface.
+// The value is an interval string.
+func (i Interval) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return formatInterval(i.Duration.Duration), nil
+}
+
+// Format implements the fmt.Formatter interface.
+func (i Interval) Format(state fmt.State, verb rune) {
+	format(state, verb, i, i.Valid, i.Duration.Duration)
+}
+
+// String implements the fmt.Stringer interface.
+func (i Interval) String() string {
+	return fmt.Sprint(i)
+}
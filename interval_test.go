@@ -0,0 +1,100 @@
+package null
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestIntervalValue(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{0, "00:00:00"},
+		{90 * time.Minute, "01:30:00"},
+		{-1500 * time.Millisecond, "-00:00:01.5"},
+		{100*time.Hour + time.Nanosecond, "100:00:00.000000001"},
+	}
+	for _, tt := range tests {
+		got, err := NewInterval(tt.in, true).Value()
+		if got != tt.want || err != nil {
+			t.Fatalf("want %v, but %v:", tt.want, got)
+		}
+
+		val := Interval{}
+		if err := val.Scan(got); err != nil {
+			t.Fatal(err)
+		}
+		if val != NewInterval(tt.in, true) {
+			t.Fatalf("want %v, but %v:", NewInterval(tt.in, true), val)
+		}
+	}
+}
+
+func TestIntervalValueMinimum(t *testing.T) {
+	val := NewInterval(time.Duration(-1<<63), true)
+	got, err := val.Value()
+	if got != "-2562047:47:16.854775808" || err != nil {
+		t.Fatalf("want %v, but %v:", "-2562047:47:16.854775808", got)
+	}
+}
+
+func TestIntervalValueNull(t *testing.T) {
+	val := NewInterval(time.Second, false)
+	got, err := val.Value()
+	if got != nil || err != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestIntervalMixedColumns(t *testing.T) {
+	row := struct {
+		Timeout Interval
+		Elapsed Duration
+	}{NewInterval(90*time.Minute, true), NewDuration(90*time.Minute, true)}
+
+	timeout, err := row.Timeout.Value()
+	if timeout != "01:30:00" || err != nil {
+		t.Fatalf("want %v, but %v:", "01:30:00", timeout)
+	}
+	elapsed, err := row.Elapsed.Value()
+	if elapsed != int64(90*time.Minute) || err != nil {
+		t.Fatalf("want %v, but %v:", int64(90*time.Minute), elapsed)
+	}
+}
+
+func TestIntervalMarshalJSON(t *testing.T) {
+	got, err := NewInterval(90*time.Minute, true).MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `"1h30m0s"`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestIntervalFormat(t *testing.T) {
+	val := NewInterval(90*time.Minute, true)
+	if got := fmt.Sprint(val); got != "1h30m0s" {
+		t.Fatalf("want %v, but %v:", "1h30m0s", got)
+	}
+
+	want := "null.Interval{Duration:null.Duration{Duration:5400000000000, Valid:true}}"
+	if got := fmt.Sprintf("%#v", val); got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestIntervalFromPtr(t *testing.T) {
+	if got := IntervalFromPtr(nil); got != (Interval{}) {
+		t.Fatalf("want %v, but %v:", Interval{}, got)
+	}
+
+	d := time.Second
+	if got, want := IntervalFromPtr(&d), IntervalFrom(time.Second); got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
@@ -2,13 +2,280 @@ package null
 
 import (
 	"bytes"
+	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"log/slog"
 )
 
+// LenientJSON makes the UnmarshalJSON methods of the numeric types and Bool accept a quoted value,
+// such as "42" or "true", which is parsed like a string passed to Scan,
+// and makes String.UnmarshalJSON accept a number or boolean as its JSON text.
+// It applies to Value and Optional of a number, bool or string as well.
+var LenientJSON = false
+
+// JSON represents a raw JSON value that may be null, such as the content of a json or jsonb column.
+// SQL NULL is represented by Valid being false, whereas the JSON literal null
+// stored in a column is represented by Valid being true and JSON being "null".
+type JSON struct {
+	JSON  json.RawMessage
+	Valid bool
+}
+
+// NewJSON creates a new JSON
+func NewJSON(j json.RawMessage, valid bool) JSON {
+	return JSON{JSON: j, Valid: valid}
+}
+
+// JSONFrom creates a new JSON that is always valid.
+func JSONFrom(j json.RawMessage) JSON {
+	return NewJSON(j, true)
+}
+
+// JSONFromPtr creates a new JSON that is null if j is nil.
+func JSONFromPtr(j *json.RawMessage) JSON {
+	if j == nil {
+		return JSON{}
+	}
+	return NewJSON(*j, true)
+}
+
+// Scan implements the Scanner interface.
+// The data is validated and copied, since drivers may reuse the buffer passed to Scan.
+func (j *JSON) Scan(value interface{}) error {
+	if value == nil {
+		j.JSON, j.Valid = nil, false
+		return nil
+	}
+
+	switch data := value.(type) {
+	case string:
+		if !json.Valid([]byte(data)) {
+			return newScanError("JSON", value, errors.New("invalid JSON"))
+		}
+		j.JSON, j.Valid = json.RawMessage(data), true
+		return nil
+	case []byte:
+		if !json.Valid(data) {
+			return newScanError("JSON", value, errors.New("invalid JSON"))
+		}
+		j.JSON, j.Valid = append(json.RawMessage{}, data...), true
+		return nil
+	default:
+		return newScanError("JSON", value, ErrUnsupportedType)
+	}
+}
+
+// Value implements the driver Valuer interface.
+func (j JSON) Value() (driver.Value, error) {
+	if !j.Valid {
+		return nil, nil
+	}
+	if len(j.JSON) == 0 {
+		return "null", nil
+	}
+	return string(j.JSON), nil
+}
+
+// MarshalJSON encode the value to JSON.
+// The raw JSON is emitted verbatim.
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if !j.Valid || len(j.JSON) == 0 {
+		return []byte("null"), nil
+	}
+	if !json.Valid(j.JSON) {
+		return nil, errors.New("invalid JSON")
+	}
+	return j.JSON, nil
+}
+
+// UnmarshalJSON decode data to the value.
+// The JSON literal null is decoded as SQL NULL.
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	if !json.Valid(data) {
+		return errors.New("invalid JSON")
+	}
+	data = bytes.TrimSpace(data)
+	j.Valid = !bytes.Equal(data, []byte("null"))
+	if j.Valid {
+		j.JSON = append(json.RawMessage{}, data...)
+	} else {
+		j.JSON = nil
+	}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (j JSON) MarshalText() ([]byte, error) {
+	if !j.Valid {
+		return []byte{}, nil
+	}
+	return j.JSON, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (j *JSON) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		j.JSON, j.Valid = nil, false
+		return nil
+	}
+	return j.Scan(text)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (j JSON) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, j.Valid, j)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (j *JSON) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, j)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (j JSON) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, j.Valid, j)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (j *JSON) UnmarshalXMLAttr(attr xml.Attr) error {
+	return j.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+// The JSON value is encoded as the equivalent YAML value.
+func (j JSON) MarshalYAML() (interface{}, error) {
+	if !j.Valid {
+		return nil, nil
+	}
+	var v interface{}
+	if err := j.Unmarshal(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+// YAML mappings must have string keys, as gopkg.in/yaml.v3 decodes them.
+func (j *JSON) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v interface{}
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		j.JSON, j.Valid = nil, false
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	j.JSON, j.Valid = data, true
+	return nil
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (j JSON) LogValue() slog.Value {
+	if !j.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(j.JSON)
+}
+
+// Format implements the fmt.Formatter interface.
+func (j JSON) Format(state fmt.State, verb rune) {
+	format(state, verb, j, j.Valid, string(j.JSON))
+}
+
+// String implements the fmt.Stringer interface.
+func (j JSON) String() string {
+	return fmt.Sprint(j)
+}
+
+// Unmarshal decodes the raw JSON into v.
+func (j JSON) Unmarshal(v interface{}) error {
+	if !j.Valid {
+		return errors.New("JSON is null")
+	}
+	return json.Unmarshal(j.JSON, v)
+}
+
+// IsJSONNull returns true if Valid is true and the value is the JSON literal null.
+func (j *JSON) IsJSONNull() bool {
+	return j.Valid && bytes.Equal(bytes.TrimSpace(j.JSON), []byte("null"))
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (j JSON) Ptr() *json.RawMessage {
+	if !j.Valid {
+		return nil
+	}
+	v := j.JSON
+	return &v
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (j JSON) ValueOrZero() json.RawMessage {
+	if !j.Valid {
+		var zero json.RawMessage
+		return zero
+	}
+	return j.JSON
+}
+
+// ValueOr returns the value, or v if it is null.
+func (j JSON) ValueOr(v json.RawMessage) json.RawMessage {
+	if !j.Valid {
+		return v
+	}
+	return j.JSON
+}
+
+// IsNull returns true if Valid is false.
+func (j *JSON) IsNull() bool {
+	return !j.Valid
+}
+
+// jsonMarshal encodes t like json.Marshal, but without escaping HTML.
 func jsonMarshal(t interface{}) ([]byte, error) {
 	buffer := &bytes.Buffer{}
 	encoder := json.NewEncoder(buffer)
 	encoder.SetEscapeHTML(false)
-	err := encoder.Encode(t)
-	return buffer.Bytes(), err
+	if err := encoder.Encode(t); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
+
+// lenientJSONString returns the string data holds if LenientJSON is true and data is a JSON string.
+func lenientJSONString(data []byte) (string, bool) {
+	data = bytes.TrimSpace(data)
+	if !LenientJSON || len(data) == 0 || data[0] != '"' {
+		return "", false
+	}
+	str, valid, ok := decodeJSONString(data)
+	return str, valid && ok
+}
+
+// lenientJSONLiteral returns the text of data if LenientJSON is true and data is a JSON number or boolean.
+func lenientJSONLiteral(data []byte) (string, bool) {
+	data = bytes.TrimSpace(data)
+	if !LenientJSON || len(data) == 0 {
+		return "", false
+	}
+	switch {
+	case bytes.Equal(data, []byte("true")), bytes.Equal(data, []byte("false")):
+		return string(data), true
+	case isJSONNumber(data):
+		return string(data), true
+	}
+	return "", false
 }
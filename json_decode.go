@@ -0,0 +1,295 @@
+package null
+
+import (
+	"strconv"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// The decodeJSON functions decode data holding null or a JSON value of one kind without
+// reflection. They report ok only for input that encoding/json decodes successfully into
+// the same Go type, with the same result, and leave everything else, including all errors,
+// to json.Unmarshal. valid is false if data is null.
+
+func decodeJSONBool(data []byte) (b, valid, ok bool) {
+	switch string(trimJSONSpace(data)) {
+	case "null":
+		return false, false, true
+	case "true":
+		return true, true, true
+	case "false":
+		return false, true, true
+	}
+	return false, false, false
+}
+
+func decodeJSONInt(data []byte, bits int) (i int64, valid, ok bool) {
+	data = trimJSONSpace(data)
+	if string(data) == "null" {
+		return 0, false, true
+	}
+	if !isJSONNumber(data) {
+		return 0, false, false
+	}
+	i, err := strconv.ParseInt(string(data), 10, bits)
+	return i, true, err == nil
+}
+
+func decodeJSONUint(data []byte, bits int) (u uint64, valid, ok bool) {
+	data = trimJSONSpace(data)
+	if string(data) == "null" {
+		return 0, false, true
+	}
+	if !isJSONNumber(data) {
+		return 0, false, false
+	}
+	u, err := strconv.ParseUint(string(data), 10, bits)
+	return u, true, err == nil
+}
+
+func decodeJSONFloat(data []byte, bits int) (f float64, valid, ok bool) {
+	data = trimJSONSpace(data)
+	if string(data) == "null" {
+		return 0, false, true
+	}
+	if !isJSONNumber(data) {
+		return 0, false, false
+	}
+	f, err := strconv.ParseFloat(string(data), bits)
+	return f, true, err == nil
+}
+
+func decodeJSONString(data []byte) (s string, valid, ok bool) {
+	data = trimJSONSpace(data)
+	if string(data) == "null" {
+		return "", false, true
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return "", false, false
+	}
+	s, ok = unquoteJSON(data[1 : len(data)-1])
+	return s, ok, ok
+}
+
+// decodeJSONValue decodes data into p, a pointer to a string, bool or number,
+// with the functions above. ok is false if p is of another type or data needs encoding/json.
+func decodeJSONValue(p interface{}, data []byte) (valid, ok bool) {
+	switch p := p.(type) {
+	case *string:
+		*p, valid, ok = decodeJSONString(data)
+	case *bool:
+		*p, valid, ok = decodeJSONBool(data)
+	case *int:
+		var i int64
+		i, valid, ok = decodeJSONInt(data, strconv.IntSize)
+		*p = int(i)
+	case *int8:
+		var i int64
+		i, valid, ok = decodeJSONInt(data, 8)
+		*p = int8(i)
+	case *int16:
+		var i int64
+		i, valid, ok = decodeJSONInt(data, 16)
+		*p = int16(i)
+	case *int32:
+		var i int64
+		i, valid, ok = decodeJSONInt(data, 32)
+		*p = int32(i)
+	case *int64:
+		*p, valid, ok = decodeJSONInt(data, 64)
+	case *uint:
+		var u uint64
+		u, valid, ok = decodeJSONUint(data, strconv.IntSize)
+		*p = uint(u)
+	case *uint8:
+		var u uint64
+		u, valid, ok = decodeJSONUint(data, 8)
+		*p = uint8(u)
+	case *uint16:
+		var u uint64
+		u, valid, ok = decodeJSONUint(data, 16)
+		*p = uint16(u)
+	case *uint32:
+		var u uint64
+		u, valid, ok = decodeJSONUint(data, 32)
+		*p = uint32(u)
+	case *uint64:
+		*p, valid, ok = decodeJSONUint(data, 64)
+	case *float32:
+		var f float64
+		f, valid, ok = decodeJSONFloat(data, 32)
+		*p = float32(f)
+	case *float64:
+		*p, valid, ok = decodeJSONFloat(data, 64)
+	}
+	return valid, ok
+}
+
+func trimJSONSpace(data []byte) []byte {
+	for len(data) > 0 && isJSONSpace(data[0]) {
+		data = data[1:]
+	}
+	for len(data) > 0 && isJSONSpace(data[len(data)-1]) {
+		data = data[:len(data)-1]
+	}
+	return data
+}
+
+func isJSONSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+// isJSONNumber reports whether data is a number in the JSON grammar:
+// -?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?
+func isJSONNumber(data []byte) bool {
+	i := 0
+	if i < len(data) && data[i] == '-' {
+		i++
+	}
+	switch {
+	case i == len(data):
+		return false
+	case data[i] == '0':
+		i++
+	case '1' <= data[i] && data[i] <= '9':
+		for i++; i < len(data) && isDigit(data[i]); i++ {
+		}
+	default:
+		return false
+	}
+	if i < len(data) && data[i] == '.' {
+		i++
+		if i == len(data) || !isDigit(data[i]) {
+			return false
+		}
+		for ; i < len(data) && isDigit(data[i]); i++ {
+		}
+	}
+	if i < len(data) && (data[i] == 'e' || data[i] == 'E') {
+		i++
+		if i < len(data) && (data[i] == '+' || data[i] == '-') {
+			i++
+		}
+		if i == len(data) || !isDigit(data[i]) {
+			return false
+		}
+		for ; i < len(data) && isDigit(data[i]); i++ {
+		}
+	}
+	return i == len(data)
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// unquoteJSON decodes the content of a JSON string, without its quotes, like encoding/json:
+// invalid UTF-8 and unpaired surrogates become U+FFFD. It reports false for an unescaped
+// quote or control character and for an invalid escape sequence.
+func unquoteJSON(s []byte) (string, bool) {
+	// Most strings have nothing to decode.
+	i := 0
+	for i < len(s) {
+		c := s[i]
+		if c == '\\' || c == '"' || c < 0x20 {
+			break
+		}
+		if c < utf8.RuneSelf {
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRune(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			break
+		}
+		i += size
+	}
+	if i == len(s) {
+		return string(s), true
+	}
+
+	b := make([]byte, i, len(s)+2*utf8.UTFMax)
+	copy(b, s[:i])
+	for i < len(s) {
+		switch c := s[i]; {
+		case c == '\\':
+			i++
+			if i == len(s) {
+				return "", false
+			}
+			switch s[i] {
+			case '"', '\\', '/':
+				b = append(b, s[i])
+				i++
+			case 'b':
+				b = append(b, '\b')
+				i++
+			case 'f':
+				b = append(b, '\f')
+				i++
+			case 'n':
+				b = append(b, '\n')
+				i++
+			case 'r':
+				b = append(b, '\r')
+				i++
+			case 't':
+				b = append(b, '\t')
+				i++
+			case 'u':
+				i--
+				r := getu4(s[i:])
+				if r < 0 {
+					return "", false
+				}
+				i += 6
+				if utf16.IsSurrogate(r) {
+					if r2 := getu4(s[i:]); r2 >= 0 {
+						if dec := utf16.DecodeRune(r, r2); dec != unicode.ReplacementChar {
+							i += 6
+							b = utf8.AppendRune(b, dec)
+							break
+						}
+					}
+					r = unicode.ReplacementChar
+				}
+				b = utf8.AppendRune(b, r)
+			default:
+				return "", false
+			}
+		case c == '"', c < 0x20:
+			return "", false
+		case c < utf8.RuneSelf:
+			b = append(b, c)
+			i++
+		default:
+			r, size := utf8.DecodeRune(s[i:])
+			i += size
+			b = utf8.AppendRune(b, r)
+		}
+	}
+	return string(b), true
+}
+
+// getu4 decodes \uXXXX from the beginning of s, returning the hex value, or -1.
+func getu4(s []byte) rune {
+	if len(s) < 6 || s[0] != '\\' || s[1] != 'u' {
+		return -1
+	}
+	var r rune
+	for _, c := range s[2:6] {
+		switch {
+		case '0' <= c && c <= '9':
+			c = c - '0'
+		case 'a' <= c && c <= 'f':
+			c = c - 'a' + 10
+		case 'A' <= c && c <= 'F':
+			c = c - 'A' + 10
+		default:
+			return -1
+		}
+		r = r*16 + rune(c)
+	}
+	return r
+}
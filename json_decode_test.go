@@ -0,0 +1,292 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var jsonDecodeSeeds = []string{
+	"null", " null ", "\tnull\r\n", "nul", "nulll", "Null", "",
+	"true", "false", " true", "True", "tru", "truex",
+	"0", "-0", "1", "-1", "01", "+1", "1.", ".1", "1.0", "1.5", "-1.5e3", "1e2", "1E+2", "1e-2", "1e", "1e+", "--1",
+	"127", "128", "-128", "-129", "255", "256", "32767", "32768", "65535", "65536",
+	"2147483647", "2147483648", "4294967295", "4294967296",
+	"9223372036854775807", "9223372036854775808", "-9223372036854775808", "-9223372036854775809",
+	"18446744073709551615", "18446744073709551616", "3.4028235e38", "3.5e38", "1e308", "1e309", "4.9e-324", "1e-400",
+	`""`, `"foo"`, ` "foo" `, `"foo`, `foo"`, `"`, `"\"`, `"a"b"`, `"a" "b"`, `"42"`, `"true"`,
+	`"\"\\\/\b\f\n\r\t"`, `"\'"`, `"\x41"`, `"\u0041"`, `"\u00e9"`, `"\u00E9"`, `"\u004"`, `"\u00zz"`,
+	`"\ud83c\udf63"`, `"🍣"`, `"\ud83c"`, `"\ud83cx"`, `"\udf63"`, `"\ud83cA"`, `"\ud83c\ud83c\udf63"`, `"\ud83c\n"`,
+	"\"\x00\"", "\"\x1f\"", "\"\x7f\"", "\"\xff\"", "\"\xe2\x80\"", "\"\xed\xa0\x80\"", "\"日本語\"", "\" \"",
+	"[]", "{}", "[1]", `{"a":1}`, "1 2", "null null", "\xef\xbb\xbfnull",
+}
+
+// testUnmarshalJSON checks that decode gives the same result as json.Unmarshal into a *T.
+func testUnmarshalJSON[T comparable](t *testing.T, data []byte, decode func([]byte) (T, bool, error)) {
+	t.Helper()
+	var want *T
+	wantErr := json.Unmarshal(data, &want)
+	got, valid, err := decode(data)
+	if (err == nil) != (wantErr == nil) || reflect.TypeOf(err) != reflect.TypeOf(wantErr) {
+		t.Fatalf("%q: want %v, but %v:", data, wantErr, err)
+	}
+	if err != nil {
+		return
+	}
+	if valid != (want != nil) {
+		t.Fatalf("%q: want %v, but %v:", data, want != nil, valid)
+	}
+	if want != nil && got != *want {
+		t.Fatalf("%q: want %v, but %v:", data, *want, got)
+	}
+}
+
+func fuzzUnmarshalJSON(f *testing.F, check func(*testing.T, []byte)) {
+	for _, seed := range jsonDecodeSeeds {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(check)
+}
+
+func FuzzUnmarshalJSONString(f *testing.F) {
+	fuzzUnmarshalJSON(f, func(t *testing.T, data []byte) {
+		testUnmarshalJSON(t, data, func(data []byte) (string, bool, error) {
+			var s String
+			err := s.UnmarshalJSON(data)
+			return s.String, s.Valid, err
+		})
+	})
+}
+
+func FuzzUnmarshalJSONBool(f *testing.F) {
+	fuzzUnmarshalJSON(f, func(t *testing.T, data []byte) {
+		testUnmarshalJSON(t, data, func(data []byte) (bool, bool, error) {
+			var b Bool
+			err := b.UnmarshalJSON(data)
+			return b.Bool, b.Valid, err
+		})
+	})
+}
+
+func FuzzUnmarshalJSONInt(f *testing.F) {
+	fuzzUnmarshalJSON(f, func(t *testing.T, data []byte) {
+		testUnmarshalJSON(t, data, func(data []byte) (int, bool, error) {
+			var i Int
+			err := i.UnmarshalJSON(data)
+			return i.Int, i.Valid, err
+		})
+		testUnmarshalJSON(t, data, func(data []byte) (int8, bool, error) {
+			var i Int8
+			err := i.UnmarshalJSON(data)
+			return i.Int8, i.Valid, err
+		})
+		testUnmarshalJSON(t, data, func(data []byte) (int16, bool, error) {
+			var i Int16
+			err := i.UnmarshalJSON(data)
+			return i.Int16, i.Valid, err
+		})
+		testUnmarshalJSON(t, data, func(data []byte) (int32, bool, error) {
+			var i Int32
+			err := i.UnmarshalJSON(data)
+			return i.Int32, i.Valid, err
+		})
+		testUnmarshalJSON(t, data, func(data []byte) (int64, bool, error) {
+			var i Int64
+			err := i.UnmarshalJSON(data)
+			return i.Int64, i.Valid, err
+		})
+	})
+}
+
+func FuzzUnmarshalJSONUint(f *testing.F) {
+	fuzzUnmarshalJSON(f, func(t *testing.T, data []byte) {
+		testUnmarshalJSON(t, data, func(data []byte) (uint, bool, error) {
+			var u Uint
+			err := u.UnmarshalJSON(data)
+			return u.Uint, u.Valid, err
+		})
+		testUnmarshalJSON(t, data, func(data []byte) (uint8, bool, error) {
+			var u Uint8
+			err := u.UnmarshalJSON(data)
+			return u.Uint8, u.Valid, err
+		})
+		testUnmarshalJSON(t, data, func(data []byte) (uint16, bool, error) {
+			var u Uint16
+			err := u.UnmarshalJSON(data)
+			return u.Uint16, u.Valid, err
+		})
+		testUnmarshalJSON(t, data, func(data []byte) (uint32, bool, error) {
+			var u Uint32
+			err := u.UnmarshalJSON(data)
+			return u.Uint32, u.Valid, err
+		})
+		testUnmarshalJSON(t, data, func(data []byte) (uint64, bool, error) {
+			var u Uint64
+			err := u.UnmarshalJSON(data)
+			return u.Uint64, u.Valid, err
+		})
+		testUnmarshalJSON(t, data, func(data []byte) (byte, bool, error) {
+			var b Byte
+			err := b.UnmarshalJSON(data)
+			return b.Byte, b.Valid, err
+		})
+	})
+}
+
+func FuzzUnmarshalJSONFloat(f *testing.F) {
+	fuzzUnmarshalJSON(f, func(t *testing.T, data []byte) {
+		testUnmarshalJSON(t, data, func(data []byte) (float32, bool, error) {
+			var f Float32
+			err := f.UnmarshalJSON(data)
+			return f.Float32, f.Valid, err
+		})
+		testUnmarshalJSON(t, data, func(data []byte) (float64, bool, error) {
+			var f Float64
+			err := f.UnmarshalJSON(data)
+			return f.Float64, f.Valid, err
+		})
+	})
+}
+
+func TestUnmarshalJSONStringEscapes(t *testing.T) {
+	tests := []struct {
+		data string
+		want string
+	}{
+		{`"\"\\\/\b\f\n\r\t"`, "\"\\/\b\f\n\r\t"},
+		{`"\u00e9\u00E9é"`, "ééé"},
+		{`"\ud83c\udf63"`, "\U0001f363"},
+		{`"\ud83c"`, "\ufffd"},
+		{`"\udf63x"`, "\ufffdx"},
+		{`"\ud83cA"`, "\ufffdA"},
+		{`"\ud83c\ud83c\udf63"`, "\ufffd\U0001f363"},
+		{"\"invalid \xff utf8\"", "invalid \ufffd utf8"},
+	}
+	for _, tt := range tests {
+		var s String
+		if err := s.UnmarshalJSON([]byte(tt.data)); err != nil {
+			t.Fatal(err)
+		}
+		if s.String != tt.want || !s.Valid {
+			t.Fatalf("want %q, but %q:", tt.want, s.String)
+		}
+	}
+}
+
+func TestUnmarshalJSONAllocs(t *testing.T) {
+	tests := []struct {
+		data string
+		val  json.Unmarshaler
+	}{
+		{"null", &String{}},
+		{`"foo"`, &String{}},
+		{`"foo\nbar"`, &String{}},
+		{"true", &Bool{}},
+		{"255", &Byte{}},
+		{"-123456", &Int{}},
+		{"-128", &Int8{}},
+		{"-32768", &Int16{}},
+		{"-123456", &Int32{}},
+		{"-9223372036854775808", &Int64{}},
+		{"123456", &Uint{}},
+		{"255", &Uint8{}},
+		{"65535", &Uint16{}},
+		{"4294967295", &Uint32{}},
+		{"18446744073709551615", &Uint64{}},
+		{"1.5", &Float32{}},
+		{"-1.25e-7", &Float64{}},
+	}
+	for _, tt := range tests {
+		data := []byte(tt.data)
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = tt.val.UnmarshalJSON(data)
+		})
+		if allocs > 1 {
+			t.Fatalf("%T: want at most 1 allocation, but %v:", tt.val, allocs)
+		}
+	}
+}
+
+// benchmarkRow is a typical row of a JSON API response.
+type benchmarkRow struct {
+	ID      Int64   `json:"id"`
+	Name    String  `json:"name"`
+	Note    String  `json:"note"`
+	Active  Bool    `json:"active"`
+	Score   Float64 `json:"score"`
+	Age     Int32   `json:"age"`
+	Retries Uint8   `json:"retries"`
+}
+
+func benchmarkRows(n int) []byte {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, `{"id":%d,"name":"user \"%d\"","note":null,"active":%t,"score":%d.25,"age":%d,"retries":%d}`,
+			i, i, i%2 == 0, i, i%100, i%8)
+	}
+	b.WriteByte(']')
+	return []byte(b.String())
+}
+
+func BenchmarkUnmarshalJSONRows(b *testing.B) {
+	data := benchmarkRows(1000)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var rows []benchmarkRow
+		if err := json.Unmarshal(data, &rows); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkStringUnmarshalJSON(b *testing.B) {
+	data := []byte(`"The quick brown fox <jumps> over the \"lazy\" dog"`)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var val String
+		_ = val.UnmarshalJSON(data)
+	}
+}
+
+func BenchmarkBoolUnmarshalJSON(b *testing.B) {
+	data := []byte("true")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var val Bool
+		_ = val.UnmarshalJSON(data)
+	}
+}
+
+func BenchmarkInt64UnmarshalJSON(b *testing.B) {
+	data := []byte("-1234567890")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var val Int64
+		_ = val.UnmarshalJSON(data)
+	}
+}
+
+func BenchmarkFloat64UnmarshalJSON(b *testing.B) {
+	data := []byte("1234.5678")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var val Float64
+		_ = val.UnmarshalJSON(data)
+	}
+}
+
+func BenchmarkNullUnmarshalJSON(b *testing.B) {
+	data := []byte("null")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var val Int64
+		_ = val.UnmarshalJSON(data)
+	}
+}
@@ -0,0 +1,178 @@
+package null
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+	"unicode/utf8"
+)
+
+// The marshalJSON functions encode a value the way encoding/json does with
+// SetEscapeHTML(false), without a trailing newline and with a single allocation.
+
+func marshalJSONBool(b bool) []byte {
+	if b {
+		return []byte("true")
+	}
+	return []byte("false")
+}
+
+func marshalJSONInt(i int64) []byte {
+	return strconv.AppendInt(make([]byte, 0, 20), i, 10)
+}
+
+func marshalJSONUint(u uint64) []byte {
+	return strconv.AppendUint(make([]byte, 0, 20), u, 10)
+}
+
+// marshalJSONFloat formats f like encoding/json, which uses the shortest
+// representation and switches to exponent form for very small or large numbers.
+func marshalJSONFloat(f float64, bits int) ([]byte, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil, &json.UnsupportedValueError{
+			Value: reflect.ValueOf(f),
+			Str:   strconv.FormatFloat(f, 'g', -1, bits),
+		}
+	}
+
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 {
+		if bits == 64 && (abs < 1e-6 || abs >= 1e21) ||
+			bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+	b := strconv.AppendFloat(make([]byte, 0, 32), f, format, -1, bits)
+	if format == 'e' {
+		// Clean up e-09 to e-9.
+		if n := len(b); n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return b, nil
+}
+
+func marshalJSONString(s string) []byte {
+	return appendJSONString(make([]byte, 0, jsonStringLen(s)), s)
+}
+
+func marshalJSONBytes(b []byte) []byte {
+	if b == nil {
+		return []byte("null")
+	}
+	buf := make([]byte, base64.StdEncoding.EncodedLen(len(b))+2)
+	buf[0] = '"'
+	base64.StdEncoding.Encode(buf[1:], b)
+	buf[len(buf)-1] = '"'
+	return buf
+}
+
+// marshalJSONHex encodes b as a JSON string holding `\x` followed by hex digits.
+func marshalJSONHex(b []byte) []byte {
+	buf := make([]byte, hex.EncodedLen(len(b))+5)
+	copy(buf, `"\\x`)
+	hex.Encode(buf[4:], b)
+	buf[len(buf)-1] = '"'
+	return buf
+}
+
+const hexDigits = "0123456789abcdef"
+
+// appendJSONString appends s to dst as a JSON string. Like encoding/json with
+// SetEscapeHTML(false), it leaves <, > and & as they are, escapes U+2028 and U+2029,
+// and replaces invalid UTF-8 with U+FFFD.
+func appendJSONString(dst []byte, s string) []byte {
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' {
+				i++
+				continue
+			}
+			dst = append(dst, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				dst = append(dst, '\\', c)
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, "\ufffd"...)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hexDigits[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	dst = append(dst, s[start:]...)
+	return append(dst, '"')
+}
+
+// jsonStringLen returns the length of s encoded by appendJSONString.
+func jsonStringLen(s string) int {
+	n := len(s) + 2
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			switch {
+			case c == '"', c == '\\', c == '\b', c == '\f', c == '\n', c == '\r', c == '\t':
+				n++
+			case c < 0x20:
+				n += 5
+			}
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			n += 2 // U+FFFD takes 3 bytes
+		case r == '\u2028' || r == '\u2029':
+			n += 3 // \u2028 takes 6 bytes
+		}
+		i += size
+	}
+	return n
+}
+
+// appendPadded appends n to dst in decimal, padded with zeros to width like the %0*d verb of fmt.
+func appendPadded(dst []byte, n, width int) []byte {
+	if n < 0 {
+		dst = append(dst, '-')
+		n = -n
+		width--
+	}
+	var buf [20]byte
+	digits := strconv.AppendInt(buf[:0], int64(n), 10)
+	for i := len(digits); i < width; i++ {
+		dst = append(dst, '0')
+	}
+	return append(dst, digits...)
+}
@@ -0,0 +1,256 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// testEncodeJSON encodes v with encoding/json the way this package used to, without escaping HTML.
+func testEncodeJSON(t *testing.T, v interface{}) string {
+	t.Helper()
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		t.Fatal(err)
+	}
+	return string(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+}
+
+func TestMarshalJSONString(t *testing.T) {
+	tests := []string{
+		"", "foo", `"quoted"`, `back\slash`, "<a href=\"x\">&amp;</a>", "tab\tnew\nline\rcr",
+		"\x00\x01\x1f\x7f", "日本語", "emoji 🍣", "line para ", "invalid \xff\xfe utf8", "\xe2\x80",
+	}
+	for _, in := range tests {
+		got, err := NewString(in, true).MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := testEncodeJSON(t, in); string(got) != want {
+			t.Fatalf("want %v, but %v:", want, string(got))
+		}
+		if len(got) != jsonStringLen(in) {
+			t.Fatalf("want %v, but %v:", len(got), jsonStringLen(in))
+		}
+	}
+}
+
+func TestMarshalJSONStringShortEscapes(t *testing.T) {
+	got, err := NewString("\b\f", true).MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `"\b\f"`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+
+	var str string
+	if err := json.Unmarshal(got, &str); err != nil || str != "\b\f" {
+		t.Fatalf("want %q, but %q:", "\b\f", str)
+	}
+}
+
+func TestMarshalJSONFloat(t *testing.T) {
+	tests := []float64{
+		0, math.Copysign(0, -1), 1, -1, 0.1, 1.5, 1e-6, 1e-7, 9.99999e-7, 1e20, 1e21, 123456789e13,
+		math.MaxFloat64, math.SmallestNonzeroFloat64, math.MaxFloat32, math.SmallestNonzeroFloat32, 1e-9, 1.5e-10,
+	}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		f := math.Float64frombits(r.Uint64())
+		if !math.IsNaN(f) && !math.IsInf(f, 0) {
+			tests = append(tests, f)
+		}
+	}
+	for _, in := range tests {
+		got, err := NewFloat64(in, true).MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := testEncodeJSON(t, in); string(got) != want {
+			t.Fatalf("want %v, but %v:", want, string(got))
+		}
+
+		f32 := float32(in)
+		if math.IsInf(float64(f32), 0) {
+			continue
+		}
+		got, err = NewFloat32(f32, true).MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := testEncodeJSON(t, f32); string(got) != want {
+			t.Fatalf("want %v, but %v:", want, string(got))
+		}
+	}
+}
+
+func TestMarshalJSONFloatUnsupported(t *testing.T) {
+	for _, in := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		_, err := NewFloat64(in, true).MarshalJSON()
+		var unsupported *json.UnsupportedValueError
+		if !errors.As(err, &unsupported) {
+			t.Fatalf("want a *json.UnsupportedValueError, but %v:", err)
+		}
+		_, err = NewFloat32(float32(in), true).MarshalJSON()
+		if !errors.As(err, &unsupported) {
+			t.Fatalf("want a *json.UnsupportedValueError, but %v:", err)
+		}
+	}
+}
+
+func TestMarshalJSONNoNewline(t *testing.T) {
+	tests := []json.Marshaler{
+		NewString("foo", true),
+		NewBool(true, true),
+		NewByte(255, true),
+		NewInt(math.MinInt, true),
+		NewInt8(math.MinInt8, true),
+		NewInt16(math.MinInt16, true),
+		NewInt32(math.MinInt32, true),
+		NewInt64(math.MinInt64, true),
+		NewUint(math.MaxUint, true),
+		NewUint8(math.MaxUint8, true),
+		NewUint16(math.MaxUint16, true),
+		NewUint32(math.MaxUint32, true),
+		NewUint64(math.MaxUint64, true),
+		NewFloat32(1.5, true),
+		NewFloat64(1.5, true),
+		NewTime(testTime, true),
+		NewBytes([]byte("foo"), true),
+		NewBytes(nil, true),
+		NewValue("<foo>", true),
+	}
+	for _, val := range tests {
+		got, err := val.MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := testEncodeJSON(t, val); string(got) != want {
+			t.Fatalf("%T: want %v, but %v:", val, want, string(got))
+		}
+	}
+}
+
+func TestAppendPadded(t *testing.T) {
+	for _, n := range []int{0, 1, -1, 9, 12, -12, 999, 2022, -2022, 12345, math.MaxInt32} {
+		for _, width := range []int{2, 4} {
+			want := fmt.Sprintf("%0*d", width, n)
+			if got := string(appendPadded(nil, n, width)); got != want {
+				t.Fatalf("want %v, but %v:", want, got)
+			}
+		}
+	}
+}
+
+func TestMarshalJSONAllocs(t *testing.T) {
+	tests := []json.Marshaler{
+		NewString("foo <bar> \"baz\"\n", true),
+		NewBool(true, true),
+		NewByte(255, true),
+		NewInt(-123456, true),
+		NewInt8(-128, true),
+		NewInt16(-32768, true),
+		NewInt32(-123456, true),
+		NewInt64(-123456, true),
+		NewUint(123456, true),
+		NewUint8(255, true),
+		NewUint16(65535, true),
+		NewUint32(123456, true),
+		NewUint64(math.MaxUint64, true),
+		NewFloat32(1.5, true),
+		NewFloat64(-1.25e-7, true),
+		NewBytes([]byte("foo"), true),
+		NewBytesHex([]byte("foo"), true),
+		NewJSON(json.RawMessage(`{"a":1}`), true),
+		NewTime(time.Date(2022, 12, 31, 23, 59, 59, 0, time.UTC), true),
+		NewUnixTime(time.Unix(1672531199, 0), true),
+		NewUnixMilliTime(time.UnixMilli(1672531199123), true),
+		NewUnixMicroTime(time.UnixMicro(1672531199123456), true),
+		NewDate(2022, time.December, 31, true),
+		NewTimeOfDay(23, 59, 59, 0, true),
+		NewDuration(90*time.Minute, true),
+		NewDurationNanos(90*time.Minute, true),
+		NewDecimal("-123.45", true),
+		NewDecimalString("-123.45", true),
+		NewUUID([16]byte{1, 2, 3}, true),
+		NewRedactedString("foo", true),
+		NewInt64(0, false),
+		NewValue(-42, true),
+		NewValue("foo", true),
+		NewOptional("foo", true),
+	}
+	for _, val := range tests {
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = val.MarshalJSON()
+		})
+		if allocs > 1 {
+			t.Fatalf("%T: want at most 1 allocation, but %v:", val, allocs)
+		}
+	}
+}
+
+func BenchmarkStringMarshalJSON(b *testing.B) {
+	val := NewString("The quick brown fox <jumps> over the \"lazy\" dog", true)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = val.MarshalJSON()
+	}
+}
+
+func BenchmarkBoolMarshalJSON(b *testing.B) {
+	val := NewBool(true, true)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = val.MarshalJSON()
+	}
+}
+
+func BenchmarkInt64MarshalJSON(b *testing.B) {
+	val := NewInt64(-1234567890, true)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = val.MarshalJSON()
+	}
+}
+
+func BenchmarkUint64MarshalJSON(b *testing.B) {
+	val := NewUint64(math.MaxUint64, true)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = val.MarshalJSON()
+	}
+}
+
+func BenchmarkFloat64MarshalJSON(b *testing.B) {
+	val := NewFloat64(1234.5678, true)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = val.MarshalJSON()
+	}
+}
+
+func BenchmarkTimeMarshalJSON(b *testing.B) {
+	val := NewTime(time.Date(2022, 12, 31, 23, 59, 59, 123456789, time.UTC), true)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = val.MarshalJSON()
+	}
+}
+
+func BenchmarkNullMarshalJSON(b *testing.B) {
+	val := NewInt64(0, false)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = val.MarshalJSON()
+	}
+}
@@ -0,0 +1,419 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestJSONScanNull(t *testing.T) {
+	val := NewJSON(json.RawMessage(`{}`), true)
+	if err := val.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewJSON(nil, false)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestJSONScanString(t *testing.T) {
+	val := JSON{}
+	if err := val.Scan(`{"foo":1}`); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewJSON(json.RawMessage(`{"foo":1}`), true)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestJSONScanByte(t *testing.T) {
+	val := JSON{}
+	data := []byte(`[1,2]`)
+	if err := val.Scan(data); err != nil {
+		t.Fatal(err)
+	}
+	copy(data, "[3,4]")
+
+	want := NewJSON(json.RawMessage(`[1,2]`), true)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestJSONScanJSONNull(t *testing.T) {
+	val := JSON{}
+	if err := val.Scan([]byte("null")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewJSON(json.RawMessage("null"), true)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+	if !val.IsJSONNull() || val.IsNull() {
+		t.Fatal("it has to be JSON null but not SQL NULL")
+	}
+}
+
+func TestJSONScanStringInvalid(t *testing.T) {
+	val := JSON{}
+	err := val.Scan("{foo")
+	if err == nil || err.Error() != "cannot scan string into JSON: invalid JSON" {
+		t.Fatalf("want %v, but %v:", "cannot scan string into JSON: invalid JSON", err)
+	}
+}
+
+func TestJSONScanByteInvalid(t *testing.T) {
+	val := JSON{}
+	err := val.Scan([]byte(""))
+	if err == nil || err.Error() != "cannot scan []uint8 into JSON: invalid JSON" {
+		t.Fatalf("want %v, but %v:", "cannot scan []uint8 into JSON: invalid JSON", err)
+	}
+}
+
+func TestJSONScanTypeError(t *testing.T) {
+	val := JSON{}
+	err := val.Scan(struct{}{})
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+}
+
+func TestJSONValue(t *testing.T) {
+	val := NewJSON(json.RawMessage(`{"foo":1}`), true)
+	got, err := val.Value()
+	if got != `{"foo":1}` || err != nil {
+		t.Fatalf("want %v, but %v:", `{"foo":1}`, got)
+	}
+}
+
+func TestJSONValueJSONNull(t *testing.T) {
+	val := NewJSON(json.RawMessage("null"), true)
+	got, err := val.Value()
+	if got != "null" || err != nil {
+		t.Fatalf("want %v, but %v:", "null", got)
+	}
+}
+
+func TestJSONValueNull(t *testing.T) {
+	val := NewJSON(json.RawMessage(`{}`), false)
+	got, err := val.Value()
+	if got != nil || err != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestJSONMarshalJSON(t *testing.T) {
+	val := struct {
+		Data JSON `json:"data"`
+	}{Data: NewJSON(json.RawMessage(`{"foo":[1,"bar"]}`), true)}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"data":{"foo":[1,"bar"]}}`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestJSONMarshalJSONNull(t *testing.T) {
+	val := NewJSON(json.RawMessage(`{}`), false)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "null"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestJSONMarshalJSONInvalid(t *testing.T) {
+	val := NewJSON(json.RawMessage(`{foo`), true)
+	_, err := val.MarshalJSON()
+	if err == nil || err.Error() != "invalid JSON" {
+		t.Fatalf("want %v, but %v:", "invalid JSON", err)
+	}
+}
+
+func TestJSONUnmarshalJSON(t *testing.T) {
+	var val struct {
+		Data JSON `json:"data"`
+	}
+	err := json.NewDecoder(strings.NewReader(`{"data": {"foo": 1}}`)).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewJSON(json.RawMessage(`{"foo": 1}`), true)
+	if !reflect.DeepEqual(val.Data, want) {
+		t.Fatalf("want %v, but %v:", want, val.Data)
+	}
+}
+
+func TestJSONUnmarshalJSONNull(t *testing.T) {
+	val := NewJSON(json.RawMessage(`{}`), true)
+	err := json.NewDecoder(strings.NewReader("null")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewJSON(nil, false)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestJSONUnmarshalJSONError(t *testing.T) {
+	val := JSON{}
+	err := val.UnmarshalJSON([]byte("foo"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestJSONUnmarshal(t *testing.T) {
+	val := NewJSON(json.RawMessage(`{"foo":1}`), true)
+	var got map[string]int
+	if err := val.Unmarshal(&got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]int{"foo": 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestJSONUnmarshalNull(t *testing.T) {
+	val := NewJSON(nil, false)
+	var got map[string]int
+	err := val.Unmarshal(&got)
+	if err == nil || err.Error() != "JSON is null" {
+		t.Fatalf("want %v, but %v:", "JSON is null", err)
+	}
+}
+
+func TestJSONMarshalText(t *testing.T) {
+	val := NewJSON(json.RawMessage(`{"foo":1}`), true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"foo":1}`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestJSONMarshalTextNull(t *testing.T) {
+	val := NewJSON(json.RawMessage(`{}`), false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestJSONUnmarshalText(t *testing.T) {
+	var val JSON
+	if err := val.UnmarshalText([]byte(`{"foo":1}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewJSON(json.RawMessage(`{"foo":1}`), true)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestJSONUnmarshalTextEmpty(t *testing.T) {
+	val := NewJSON(json.RawMessage(`{}`), true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewJSON(nil, false)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestJSONUnmarshalTextError(t *testing.T) {
+	val := JSON{}
+	err := val.UnmarshalText([]byte("{foo"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestJSONIsNull(t *testing.T) {
+	val := NewJSON(json.RawMessage("null"), true)
+	if val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+
+	val = NewJSON(nil, false)
+	if !val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+}
+
+func TestLenientJSON(t *testing.T) {
+	defer func(b bool) { LenientJSON = b }(LenientJSON)
+	LenientJSON = true
+
+	var val struct {
+		Int     Int     `json:"int"`
+		Int8    Int8    `json:"int8"`
+		Int16   Int16   `json:"int16"`
+		Int32   Int32   `json:"int32"`
+		Int64   Int64   `json:"int64"`
+		Uint    Uint    `json:"uint"`
+		Uint8   Uint8   `json:"uint8"`
+		Uint16  Uint16  `json:"uint16"`
+		Uint32  Uint32  `json:"uint32"`
+		Uint64  Uint64  `json:"uint64"`
+		Byte    Byte    `json:"byte"`
+		Float32 Float32 `json:"float32"`
+		Float64 Float64 `json:"float64"`
+		Bool    Bool    `json:"bool"`
+		Number  String  `json:"number"`
+		Boolean String  `json:"boolean"`
+		Null    Int     `json:"null"`
+	}
+	data := `{"int":"-1","int8":"-8","int16":"16","int32":"32","int64":"64","uint":"1","uint8":"8","uint16":"16",
+		"uint32":"32","uint64":"18446744073709551615","byte":"255","float32":"1.5","float64":"-2.5e3",
+		"bool":"true","number":-1.50e3,"boolean":false,"null":null}`
+	if err := json.NewDecoder(strings.NewReader(data)).Decode(&val); err != nil {
+		t.Fatal(err)
+	}
+
+	if val.Int != NewInt(-1, true) || val.Int8 != NewInt8(-8, true) || val.Int16 != NewInt16(16, true) ||
+		val.Int32 != NewInt32(32, true) || val.Int64 != NewInt64(64, true) {
+		t.Fatalf("unexpected ints: %v", val)
+	}
+	if val.Uint != NewUint(1, true) || val.Uint8 != NewUint8(8, true) || val.Uint16 != NewUint16(16, true) ||
+		val.Uint32 != NewUint32(32, true) || val.Uint64 != NewUint64(18446744073709551615, true) || val.Byte != NewByte(255, true) {
+		t.Fatalf("unexpected uints: %v", val)
+	}
+	if val.Float32 != NewFloat32(1.5, true) || val.Float64 != NewFloat64(-2500, true) || val.Bool != NewBool(true, true) {
+		t.Fatalf("unexpected floats or bool: %v", val)
+	}
+	if val.Number != NewString("-1.50e3", true) || val.Boolean != NewString("false", true) || val.Null != NewInt(0, false) {
+		t.Fatalf("unexpected strings or null: %v", val)
+	}
+}
+
+func TestLenientJSONError(t *testing.T) {
+	defer func(b bool) { LenientJSON = b }(LenientJSON)
+	LenientJSON = true
+
+	tests := []struct {
+		val  json.Unmarshaler
+		data string
+	}{
+		{&Int{}, `"foo"`},
+		{&Int8{}, `"128"`},
+		{&Uint{}, `"-1"`},
+		{&Byte{}, `"256"`},
+		{&Float64{}, `""`},
+		{&Bool{}, `"yes"`},
+		{&String{}, `{}`},
+		{&String{}, `[1]`},
+	}
+	for _, tt := range tests {
+		if err := tt.val.UnmarshalJSON([]byte(tt.data)); err == nil {
+			t.Fatalf("no error message is output: %T %v", tt.val, tt.data)
+		}
+	}
+}
+
+func TestLenientJSONDisabled(t *testing.T) {
+	tests := []struct {
+		val  json.Unmarshaler
+		data string
+	}{
+		{&Int{}, `"42"`},
+		{&Byte{}, `"42"`},
+		{&Float64{}, `"1.5"`},
+		{&Bool{}, `"true"`},
+		{&String{}, `42`},
+		{&String{}, `true`},
+	}
+	for _, tt := range tests {
+		if err := tt.val.UnmarshalJSON([]byte(tt.data)); err == nil {
+			t.Fatalf("no error message is output: %T %v", tt.val, tt.data)
+		}
+	}
+}
+
+func TestJSONFrom(t *testing.T) {
+	val := JSONFrom(json.RawMessage(`{"a":1}`))
+	want := NewJSON(json.RawMessage(`{"a":1}`), true)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestJSONFromPtr(t *testing.T) {
+	v := json.RawMessage(`{"a":1}`)
+	val := JSONFromPtr(&v)
+	want := NewJSON(json.RawMessage(`{"a":1}`), true)
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = JSONFromPtr(nil)
+	want = JSON{}
+	if !reflect.DeepEqual(val, want) {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestJSONPtr(t *testing.T) {
+	val := NewJSON(json.RawMessage(`{"a":1}`), true)
+	got := val.Ptr()
+	if got == nil || !reflect.DeepEqual(*got, json.RawMessage(`{"a":1}`)) {
+		t.Fatalf("want %v, but %v:", json.RawMessage(`{"a":1}`), got)
+	}
+
+	val = NewJSON(json.RawMessage(`{"a":1}`), false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestJSONValueOr(t *testing.T) {
+	val := NewJSON(json.RawMessage(`{"a":1}`), true)
+	if got := val.ValueOrZero(); !reflect.DeepEqual(got, json.RawMessage(`{"a":1}`)) {
+		t.Fatalf("want %v, but %v:", json.RawMessage(`{"a":1}`), got)
+	}
+	if got := val.ValueOr(json.RawMessage(`{"a":2}`)); !reflect.DeepEqual(got, json.RawMessage(`{"a":1}`)) {
+		t.Fatalf("want %v, but %v:", json.RawMessage(`{"a":1}`), got)
+	}
+
+	val = NewJSON(json.RawMessage(`{"a":1}`), false)
+	if got := val.ValueOrZero(); !reflect.DeepEqual(got, json.RawMessage(nil)) {
+		t.Fatalf("want %v, but %v:", json.RawMessage(nil), got)
+	}
+	if got := val.ValueOr(json.RawMessage(`{"a":2}`)); !reflect.DeepEqual(got, json.RawMessage(`{"a":2}`)) {
+		t.Fatalf("want %v, but %v:", json.RawMessage(`{"a":2}`), got)
+	}
+}
@@ -0,0 +1,237 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+)
+
+// Optional represents a value of any type T that may be null and remembers whether it was set.
+// It tells apart a key missing from a JSON object (Set is false) from a key whose value is null
+// (Set is true and Valid is false), which is what PATCH requests need.
+type Optional[T any] struct {
+	V     T
+	Valid bool
+	Set   bool
+}
+
+// NewOptional creates a new Optional that is set.
+func NewOptional[T any](v T, valid bool) Optional[T] {
+	return Optional[T]{V: v, Valid: valid, Set: true}
+}
+
+// OptionalFrom creates a new Optional that is set and valid.
+func OptionalFrom[T any](v T) Optional[T] {
+	return NewOptional(v, true)
+}
+
+// OptionalFromPtr creates a new Optional that is set, and null if v is nil.
+func OptionalFromPtr[T any](v *T) Optional[T] {
+	if v == nil {
+		return Optional[T]{Set: true}
+	}
+	return NewOptional(*v, true)
+}
+
+// Scan implements the Scanner interface.
+func (o *Optional[T]) Scan(value interface{}) error {
+	n := Value[T]{}
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	o.V, o.Valid, o.Set = n.V, n.Valid, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (o Optional[T]) Value() (driver.Value, error) {
+	return Value[T]{V: o.V, Valid: o.Valid}.Value()
+}
+
+// MarshalJSON encode the value to JSON.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	return Value[T]{V: o.V, Valid: o.Valid}.MarshalJSON()
+}
+
+// UnmarshalJSON decode data to the value.
+// It is only called for keys that are present, so Set is always true afterwards.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	n := Value[T]{}
+	if err := n.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	o.V, o.Valid, o.Set = n.V, n.Valid, true
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (o Optional[T]) MarshalText() ([]byte, error) {
+	return Value[T]{V: o.V, Valid: o.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (o *Optional[T]) UnmarshalText(text []byte) error {
+	n := Value[T]{}
+	if err := n.UnmarshalText(text); err != nil {
+		return err
+	}
+	o.V, o.Valid, o.Set = n.V, n.Valid, true
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (o Optional[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, o.Valid, o)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (o *Optional[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, o)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (o Optional[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, o.Valid, o)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (o *Optional[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return o.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (o Optional[T]) MarshalYAML() (interface{}, error) {
+	return Value[T]{V: o.V, Valid: o.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so a key set to null cannot be told apart from a missing key.
+func (o *Optional[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[T]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
+	}
+	o.V, o.Valid, o.Set = n.V, n.Valid, true
+	return nil
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (o Optional[T]) LogValue() slog.Value {
+	if !o.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(o.V)
+}
+
+// Format implements the fmt.Formatter interface.
+func (o Optional[T]) Format(state fmt.State, verb rune) {
+	format(state, verb, o, o.Valid, o.V)
+}
+
+// String implements the fmt.Stringer interface.
+func (o Optional[T]) String() string {
+	return fmt.Sprint(o)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (o Optional[T]) Ptr() *T {
+	return Value[T]{V: o.V, Valid: o.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (o Optional[T]) ValueOrZero() T {
+	return Value[T]{V: o.V, Valid: o.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (o Optional[T]) ValueOr(v T) T {
+	return Value[T]{V: o.V, Valid: o.Valid}.ValueOr(v)
+}
+
+// IsSet returns true if Set is true.
+func (o *Optional[T]) IsSet() bool {
+	return o.Set
+}
+
+// IsNull returns true if Valid is false.
+func (o *Optional[T]) IsNull() bool {
+	return !o.Valid
+}
+
+// Update is a column to update and the value to set it to.
+type Update struct {
+	Column string
+	Value  interface{}
+}
+
+// Updates returns the columns to update for the struct v, i.e. its Optional fields that are set.
+// The column name is taken from the "db" tag, then the "json" tag, then the field name.
+// Fields tagged `db:"-"` are skipped and embedded structs are walked.
+// Each Value is the Optional itself, which can be passed to database/sql as a query argument.
+func Updates(v interface{}) ([]Update, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedType, v)
+	}
+	if !rv.CanAddr() {
+		addressable := reflect.New(rv.Type()).Elem()
+		addressable.Set(rv)
+		rv = addressable
+	}
+	return appendUpdates(nil, rv), nil
+}
+
+type setter interface {
+	IsSet() bool
+}
+
+var setterType = reflect.TypeOf((*setter)(nil)).Elem()
+
+func appendUpdates(updates []Update, rv reflect.Value) []Update {
+	for i := 0; i < rv.NumField(); i++ {
+		field, fv := rv.Type().Field(i), rv.Field(i)
+		if field.Anonymous && fv.Kind() == reflect.Struct && !reflect.PointerTo(field.Type).Implements(setterType) {
+			updates = appendUpdates(updates, fv)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		name, ok := columnName(field)
+		if !ok {
+			continue
+		}
+		if s, ok := fv.Addr().Interface().(setter); ok && s.IsSet() {
+			updates = append(updates, Update{Column: name, Value: fv.Interface()})
+		}
+	}
+	return updates
+}
+
+func columnName(field reflect.StructField) (string, bool) {
+	for _, key := range []string{"db", "json"} {
+		tag, ok := field.Tag.Lookup(key)
+		if !ok {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "-" {
+			return "", false
+		}
+		if name != "" {
+			return name, true
+		}
+	}
+	return field.Name, true
+}
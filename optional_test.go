@@ -0,0 +1,278 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestOptionalScanNull(t *testing.T) {
+	val := Optional[string]{}
+	if err := val.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewOptional("", false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestOptionalScan(t *testing.T) {
+	val := Optional[int64]{}
+	if err := val.Scan("1"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewOptional(int64(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestOptionalScanError(t *testing.T) {
+	val := Optional[int64]{}
+	err := val.Scan(struct{}{})
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+}
+
+func TestOptionalValue(t *testing.T) {
+	val := NewOptional("foo", true)
+	got, err := val.Value()
+	if got != "foo" || err != nil {
+		t.Fatalf("want %v, but %v:", "foo", got)
+	}
+}
+
+func TestOptionalValueNull(t *testing.T) {
+	val := NewOptional("foo", false)
+	got, err := val.Value()
+	if got != nil || err != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestOptionalMarshalJSON(t *testing.T) {
+	val := NewOptional("foo", true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `"foo"`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestOptionalMarshalJSONNull(t *testing.T) {
+	val := NewOptional("foo", false)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "null"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+type testPatch struct {
+	Name  Optional[string] `json:"name"`
+	Age   Optional[int]    `json:"age" db:"user_age"`
+	Email Optional[string] `json:"email"`
+}
+
+func TestOptionalUnmarshalJSON(t *testing.T) {
+	var val testPatch
+	err := json.NewDecoder(strings.NewReader(`{"name":"foo","age":null}`)).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := testPatch{
+		Name:  NewOptional("foo", true),
+		Age:   NewOptional(0, false),
+		Email: Optional[string]{},
+	}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestOptionalUnmarshalJSONError(t *testing.T) {
+	val := Optional[int]{}
+	err := val.UnmarshalJSON([]byte(`"foo"`))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+	if val.Set {
+		t.Fatal("it has to be not set")
+	}
+}
+
+func TestOptionalMarshalText(t *testing.T) {
+	val := NewOptional(1, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "1"
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestOptionalUnmarshalText(t *testing.T) {
+	var val Optional[int]
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewOptional(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestOptionalUnmarshalTextError(t *testing.T) {
+	var val Optional[int]
+	if err := val.UnmarshalText([]byte("foo")); err == nil {
+		t.Fatal("no error message is output")
+	}
+	if val.Set {
+		t.Fatal("it has to be not set")
+	}
+}
+
+func TestOptionalIsSet(t *testing.T) {
+	val := NewOptional(0, false)
+	if !val.IsSet() {
+		t.Fatal("it has to be set")
+	}
+
+	val = Optional[int]{}
+	if val.IsSet() {
+		t.Fatal("it has to be not set")
+	}
+}
+
+func TestOptionalIsNull(t *testing.T) {
+	val := NewOptional(0, true)
+	if val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+
+	val = NewOptional(0, false)
+	if !val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+}
+
+func TestUpdates(t *testing.T) {
+	val := testPatch{
+		Name: NewOptional("foo", true),
+		Age:  NewOptional(0, false),
+	}
+	got, err := Updates(val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Update{
+		{Column: "name", Value: NewOptional("foo", true)},
+		{Column: "user_age", Value: NewOptional(0, false)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUpdatesEmbedded(t *testing.T) {
+	type embedded struct {
+		testPatch
+		ID      int
+		Ignored Optional[int] `db:"-"`
+		Comment Optional[string]
+	}
+	val := &embedded{
+		testPatch: testPatch{Email: NewOptional("foo@example.com", true)},
+		Ignored:   NewOptional(1, true),
+		Comment:   NewOptional("", true),
+	}
+	got, err := Updates(val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Update{
+		{Column: "email", Value: NewOptional("foo@example.com", true)},
+		{Column: "Comment", Value: NewOptional("", true)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUpdatesError(t *testing.T) {
+	_, err := Updates(1)
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+}
+
+func TestOptionalFrom(t *testing.T) {
+	val := OptionalFrom("foo")
+	want := NewOptional("foo", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestOptionalFromPtr(t *testing.T) {
+	v := "foo"
+	val := OptionalFromPtr(&v)
+	want := NewOptional("foo", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = OptionalFromPtr[string](nil)
+	want = NewOptional("", false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestOptionalPtrValueOr(t *testing.T) {
+	val := NewOptional("foo", true)
+	if got := val.Ptr(); got == nil || *got != "foo" {
+		t.Fatalf("want %v, but %v:", "foo", got)
+	}
+	if got := val.ValueOrZero(); got != "foo" {
+		t.Fatalf("want %v, but %v:", "foo", got)
+	}
+	if got := val.ValueOr("bar"); got != "foo" {
+		t.Fatalf("want %v, but %v:", "foo", got)
+	}
+
+	val = Optional[string]{}
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+	if got := val.ValueOrZero(); got != "" {
+		t.Fatalf("want %v, but %v:", "", got)
+	}
+	if got := val.ValueOr("bar"); got != "bar" {
+		t.Fatalf("want %v, but %v:", "bar", got)
+	}
+}
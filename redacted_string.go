@@ -0,0 +1,46 @@
+package null
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+const redacted = "[REDACTED]"
+
+// RedactedString represents a string that may be null and whose value is hidden by LogValue and
+// the fmt package, for fields holding personally identifiable information.
+// It behaves like String in every other respect. In particular MarshalJSON encodes the value,
+// so it is not hidden when a struct holding it is logged by slog.JSONHandler.
+type RedactedString struct {
+	String
+}
+
+// NewRedactedString creates a new RedactedString
+func NewRedactedString(str string, valid bool) RedactedString {
+	return RedactedString{String: NewString(str, valid)}
+}
+
+// RedactedStringFrom creates a new RedactedString that is always valid.
+func RedactedStringFrom(s string) RedactedString {
+	return NewRedactedString(s, true)
+}
+
+// RedactedStringFromPtr creates a new RedactedString that is null if s is nil.
+func RedactedStringFromPtr(s *string) RedactedString {
+	return RedactedString{String: StringFromPtr(s)}
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil and any other value as "[REDACTED]".
+func (s RedactedString) LogValue() slog.Value {
+	if !s.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(redacted)
+}
+
+// Format implements the fmt.Formatter interface.
+// The value is printed as "[REDACTED]", except by %#v.
+func (s RedactedString) Format(state fmt.State, verb rune) {
+	format(state, verb, s, s.Valid, redacted)
+}
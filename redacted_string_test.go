@@ -0,0 +1,87 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestRedactedStringScan(t *testing.T) {
+	val := RedactedString{}
+	if err := val.Scan("foo"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewRedactedString("foo", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestRedactedStringMarshalJSON(t *testing.T) {
+	val := NewRedactedString("foo", true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `"foo"`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestRedactedStringLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{ReplaceAttr: testRemoveTime}))
+	logger.Info("msg", "email", NewRedactedString("foo@example.com", true), "phone", NewRedactedString("", false))
+
+	want := `level=INFO msg=msg email=[REDACTED] phone=<nil>`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestRedactedStringLogStruct(t *testing.T) {
+	user := struct{ Email RedactedString }{NewRedactedString("foo@example.com", true)}
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{ReplaceAttr: testRemoveTime}))
+	logger.Info("msg", "user", user)
+
+	want := `level=INFO msg=msg user={Email:[REDACTED]}`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+
+	// slog.JSONHandler encodes a struct with MarshalJSON, which does not redact.
+	buf.Reset()
+	logger = slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: testRemoveTime}))
+	logger.Info("msg", "user", user)
+
+	want = `{"level":"INFO","msg":"msg","user":{"Email":"foo@example.com"}}`
+	got = strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestRedactedStringFromPtr(t *testing.T) {
+	v := "secret"
+	val := RedactedStringFromPtr(&v)
+	want := RedactedStringFrom("secret")
+	if val != want || !val.Valid {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = RedactedStringFromPtr(nil)
+	want = RedactedString{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
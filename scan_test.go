@@ -0,0 +1,139 @@
+package null
+
+import (
+	"database/sql"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestScanErrorLeavesValueUnchanged(t *testing.T) {
+	tests := []struct {
+		val sql.Scanner
+		in  interface{}
+	}{
+		{&String{String: "foo", Valid: true}, struct{}{}},
+
+		{&Bool{Bool: true, Valid: true}, "foo"},
+		{&Bool{Bool: true, Valid: true}, []byte("foo")},
+		{&Bool{Bool: true, Valid: true}, uint8(2)},
+		{&Bool{Bool: true, Valid: true}, uint16(2)},
+		{&Bool{Bool: true, Valid: true}, uint32(2)},
+		{&Bool{Bool: true, Valid: true}, uint64(2)},
+		{&Bool{Bool: true, Valid: true}, int(2)},
+		{&Bool{Bool: true, Valid: true}, int8(2)},
+		{&Bool{Bool: true, Valid: true}, int16(2)},
+		{&Bool{Bool: true, Valid: true}, int32(2)},
+		{&Bool{Bool: true, Valid: true}, int64(2)},
+		{&Bool{Bool: true, Valid: true}, struct{}{}},
+
+		{&Byte{Byte: 1, Valid: true}, int64(256)},
+		{&Byte{Byte: 1, Valid: true}, int64(-1)},
+		{&Byte{Byte: 1, Valid: true}, 1.5},
+		{&Byte{Byte: 1, Valid: true}, "256"},
+		{&Byte{Byte: 1, Valid: true}, []byte("foo")},
+
+		{&Int{Int: 1, Valid: true}, "foo"},
+		{&Int{Int: 1, Valid: true}, []byte("foo")},
+		{&Int{Int: 1, Valid: true}, uint64(math.MaxUint64)},
+		{&Int{Int: 1, Valid: true}, 1.5},
+		{&Int{Int: 1, Valid: true}, struct{}{}},
+		{&Int8{Int8: 1, Valid: true}, "128"},
+		{&Int8{Int8: 1, Valid: true}, []byte("foo")},
+		{&Int8{Int8: 1, Valid: true}, int64(128)},
+		{&Int8{Int8: 1, Valid: true}, 1.5},
+		{&Int8{Int8: 1, Valid: true}, struct{}{}},
+		{&Int16{Int16: 1, Valid: true}, "foo"},
+		{&Int16{Int16: 1, Valid: true}, []byte("foo")},
+		{&Int16{Int16: 1, Valid: true}, int64(math.MaxInt16 + 1)},
+		{&Int16{Int16: 1, Valid: true}, 1.5},
+		{&Int16{Int16: 1, Valid: true}, struct{}{}},
+		{&Int32{Int32: 1, Valid: true}, "foo"},
+		{&Int32{Int32: 1, Valid: true}, []byte("foo")},
+		{&Int32{Int32: 1, Valid: true}, int64(math.MaxInt32 + 1)},
+		{&Int32{Int32: 1, Valid: true}, 1.5},
+		{&Int32{Int32: 1, Valid: true}, struct{}{}},
+		{&Int64{Int64: 1, Valid: true}, "foo"},
+		{&Int64{Int64: 1, Valid: true}, []byte("foo")},
+		{&Int64{Int64: 1, Valid: true}, uint64(math.MaxUint64)},
+		{&Int64{Int64: 1, Valid: true}, 1.5},
+		{&Int64{Int64: 1, Valid: true}, struct{}{}},
+
+		{&Uint{Uint: 1, Valid: true}, "-1"},
+		{&Uint{Uint: 1, Valid: true}, []byte("foo")},
+		{&Uint{Uint: 1, Valid: true}, int64(-1)},
+		{&Uint{Uint: 1, Valid: true}, 1.5},
+		{&Uint{Uint: 1, Valid: true}, struct{}{}},
+		{&Uint8{Uint8: 1, Valid: true}, "256"},
+		{&Uint8{Uint8: 1, Valid: true}, []byte("foo")},
+		{&Uint8{Uint8: 1, Valid: true}, int64(256)},
+		{&Uint8{Uint8: 1, Valid: true}, 1.5},
+		{&Uint8{Uint8: 1, Valid: true}, struct{}{}},
+		{&Uint16{Uint16: 1, Valid: true}, "foo"},
+		{&Uint16{Uint16: 1, Valid: true}, []byte("foo")},
+		{&Uint16{Uint16: 1, Valid: true}, int64(math.MaxUint16 + 1)},
+		{&Uint16{Uint16: 1, Valid: true}, 1.5},
+		{&Uint16{Uint16: 1, Valid: true}, struct{}{}},
+		{&Uint32{Uint32: 1, Valid: true}, "foo"},
+		{&Uint32{Uint32: 1, Valid: true}, []byte("foo")},
+		{&Uint32{Uint32: 1, Valid: true}, int64(math.MaxUint32 + 1)},
+		{&Uint32{Uint32: 1, Valid: true}, 1.5},
+		{&Uint32{Uint32: 1, Valid: true}, struct{}{}},
+		{&Uint64{Uint64: 1, Valid: true}, "foo"},
+		{&Uint64{Uint64: 1, Valid: true}, []byte("foo")},
+		{&Uint64{Uint64: 1, Valid: true}, int64(-1)},
+		{&Uint64{Uint64: 1, Valid: true}, 1.5},
+		{&Uint64{Uint64: 1, Valid: true}, struct{}{}},
+
+		{&Float32{Float32: 1, Valid: true}, "foo"},
+		{&Float32{Float32: 1, Valid: true}, []byte("foo")},
+		{&Float32{Float32: 1, Valid: true}, struct{}{}},
+		{&Float64{Float64: 1, Valid: true}, "foo"},
+		{&Float64{Float64: 1, Valid: true}, []byte("foo")},
+		{&Float64{Float64: 1, Valid: true}, struct{}{}},
+
+		{&Time{Time: testTime, Valid: true}, "foo"},
+		{&Time{Time: testTime, Valid: true}, []byte("foo")},
+		{&Time{Time: testTime, Valid: true}, struct{}{}},
+		{&Bytes{Bytes: []byte("foo"), Valid: true}, struct{}{}},
+		{&JSON{JSON: []byte("{}"), Valid: true}, "{foo"},
+		{&JSON{JSON: []byte("{}"), Valid: true}, []byte("{foo")},
+		{&JSON{JSON: []byte("{}"), Valid: true}, struct{}{}},
+		{&Decimal{Decimal: "1.5", Valid: true}, "foo"},
+		{&Decimal{Decimal: "1.5", Valid: true}, []byte("foo")},
+		{&Decimal{Decimal: "1.5", Valid: true}, float32(math.NaN())},
+		{&Decimal{Decimal: "1.5", Valid: true}, math.Inf(1)},
+		{&Decimal{Decimal: "1.5", Valid: true}, struct{}{}},
+		{&UUID{UUID: [16]byte{1}, Valid: true}, "foo"},
+		{&UUID{UUID: [16]byte{1}, Valid: true}, []byte("foo")},
+		{&UUID{UUID: [16]byte{1}, Valid: true}, struct{}{}},
+		{&Date{Year: 2022, Month: time.January, Day: 2, Valid: true}, "foo"},
+		{&Date{Year: 2022, Month: time.January, Day: 2, Valid: true}, []byte("foo")},
+		{&Date{Year: 2022, Month: time.January, Day: 2, Valid: true}, struct{}{}},
+		{&TimeOfDay{Hour: 1, Valid: true}, "foo"},
+		{&TimeOfDay{Hour: 1, Valid: true}, []byte("foo")},
+		{&TimeOfDay{Hour: 1, Valid: true}, struct{}{}},
+		{&Duration{Duration: time.Second, Valid: true}, "foo"},
+		{&Duration{Duration: time.Second, Valid: true}, []byte("foo")},
+		{&Duration{Duration: time.Second, Valid: true}, struct{}{}},
+
+		{&Value[testID]{V: testID{id: 1}, Valid: true}, "foo"},
+		{&Value[uint8]{V: 1, Valid: true}, int64(256)},
+		{&Value[uint8]{V: 1, Valid: true}, 1.5},
+		{&Value[uint8]{V: 1, Valid: true}, "foo"},
+		{&Value[uint8]{V: 1, Valid: true}, struct{}{}},
+		{&Optional[uint8]{V: 1, Valid: true}, "foo"},
+		{&Optional[uint8]{}, "foo"},
+	}
+	for _, tt := range tests {
+		before := reflect.ValueOf(tt.val).Elem().Interface()
+		if err := tt.val.Scan(tt.in); err == nil {
+			t.Fatalf("%T %#v: no error message is output", tt.val, tt.in)
+		}
+		after := reflect.ValueOf(tt.val).Elem().Interface()
+		if !reflect.DeepEqual(before, after) {
+			t.Fatalf("%T %#v: want %#v, but %#v:", tt.val, tt.in, before, after)
+		}
+	}
+}
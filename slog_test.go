@@ -0,0 +1,88 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testRemoveTime(groups []string, a slog.Attr) slog.Attr {
+	if a.Key == slog.TimeKey && len(groups) == 0 {
+		return slog.Attr{}
+	}
+	return a
+}
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: testRemoveTime}))
+	logger.Info("msg",
+		"string", NewString("foo", true),
+		"bool", NewBool(true, true),
+		"byte", NewByte(1, true),
+		"int", NewInt(-1, true),
+		"int8", NewInt8(-8, true),
+		"int16", NewInt16(-16, true),
+		"int32", NewInt32(-32, true),
+		"int64", NewInt64(-64, true),
+		"uint", NewUint(1, true),
+		"uint8", NewUint8(8, true),
+		"uint16", NewUint16(16, true),
+		"uint32", NewUint32(32, true),
+		"uint64", NewUint64(64, true),
+		"float32", NewFloat32(1.5, true),
+		"float64", NewFloat64(2.5, true),
+		"timestamp", NewTime(time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC), true),
+		"bytes", NewBytes([]byte("foo"), true),
+		"json", NewJSON(json.RawMessage(`{"foo":1}`), true),
+		"decimal", NewDecimal("1.50", true),
+		"value", NewValue("bar", true),
+		"optional", NewOptional(2, true),
+	)
+
+	want := `{"level":"INFO","msg":"msg","string":"foo","bool":true,"byte":1,` +
+		`"int":-1,"int8":-8,"int16":-16,"int32":-32,"int64":-64,` +
+		`"uint":1,"uint8":8,"uint16":16,"uint32":32,"uint64":64,` +
+		`"float32":1.5,"float64":2.5,"timestamp":"2022-01-02T03:04:05Z",` +
+		`"bytes":"Zm9v","json":{"foo":1},"decimal":"1.50","value":"bar","optional":2}`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestLogValueNull(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: testRemoveTime}))
+	logger.Info("msg",
+		"string", String{},
+		"int", Int{},
+		"timestamp", Time{},
+		"json", JSON{},
+		"value", Value[string]{},
+	)
+
+	want := `{"level":"INFO","msg":"msg","string":null,"int":null,"timestamp":null,"json":null,"value":null}`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestLogValueText(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{ReplaceAttr: testRemoveTime}))
+	logger.Info("msg", "user", slog.GroupValue(
+		slog.Any("name", NewString("foo bar", true)),
+		slog.Any("age", NewInt(0, false)),
+	))
+
+	want := `level=INFO msg=msg user.name="foo bar" user.age=<nil>`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
@@ -1,9 +1,11 @@
 package null
 
 import (
+	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"log/slog"
 )
 
 // String represents a string that may be null.
@@ -17,57 +19,141 @@ func NewString(str string, valid bool) String {
 	return String{String: str, Valid: valid}
 }
 
-// Scan implements the Scanner interface.
-func (s *String) Scan(value interface{}) error {
-	if value == nil {
-		s.String, s.Valid = "", false
-		return nil
+// StringFrom creates a new String that is always valid.
+func StringFrom(s string) String {
+	return NewString(s, true)
+}
+
+// StringFromPtr creates a new String that is null if s is nil.
+func StringFromPtr(s *string) String {
+	if s == nil {
+		return String{}
 	}
+	return NewString(*s, true)
+}
 
-	s.Valid = true
-	switch data := value.(type) {
-	case string:
-		s.String = data
-		return nil
-	case []byte:
-		s.String = string(data)
-		return nil
-	default:
-		return fmt.Errorf("unsupported type: %T", value)
+// StringFromSQL creates a new String from a sql.NullString.
+func StringFromSQL(s sql.NullString) String {
+	return NewString(s.String, s.Valid)
+}
+
+// Scan implements the Scanner interface.
+func (s *String) Scan(value interface{}) error {
+	n := Value[string]{}
+	if err := n.scan("String", value); err != nil {
+		return err
 	}
+	s.String, s.Valid = n.V, n.Valid
+	return nil
 }
 
 // Value implements the driver Valuer interface.
 func (s String) Value() (driver.Value, error) {
-	if !s.Valid {
-		return nil, nil
-	}
-	return s.String, nil
+	return Value[string]{V: s.String, Valid: s.Valid}.Value()
 }
 
 // MarshalJSON encode the value to JSON.
 func (s String) MarshalJSON() ([]byte, error) {
-	if !s.Valid {
-		return []byte("null"), nil
-	}
-	return jsonMarshal(s.String)
+	return Value[string]{V: s.String, Valid: s.Valid}.MarshalJSON()
 }
 
 // UnmarshalJSON decode data to the value.
+// A number or boolean is accepted as well, as its JSON text, if LenientJSON is true.
 func (s *String) UnmarshalJSON(data []byte) error {
-	var str *string
-	if err := json.Unmarshal(data, &str); err != nil {
+	n := Value[string]{}
+	if err := n.unmarshalJSON("String", data); err != nil {
 		return err
 	}
-	s.Valid = str != nil
-	if s.Valid {
-		s.String = *str
-	} else {
-		s.String = ""
+	s.String, s.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (s String) MarshalText() ([]byte, error) {
+	return Value[string]{V: s.String, Valid: s.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (s *String) UnmarshalText(text []byte) error {
+	n := Value[string]{}
+	if err := n.unmarshalText("String", text); err != nil {
+		return err
 	}
+	s.String, s.Valid = n.V, n.Valid
 	return nil
 }
 
+// MarshalXML implements the xml.Marshaler interface.
+func (s String) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, s.Valid, s)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (s *String) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, s)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (s String) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, s.Valid, s)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (s *String) UnmarshalXMLAttr(attr xml.Attr) error {
+	return s.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (s String) MarshalYAML() (interface{}, error) {
+	return Value[string]{V: s.String, Valid: s.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (s *String) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[string]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
+	}
+	s.String, s.Valid = n.V, n.Valid
+	return nil
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (s String) LogValue() slog.Value {
+	return Value[string]{V: s.String, Valid: s.Valid}.LogValue()
+}
+
+// Format implements the fmt.Formatter interface.
+func (s String) Format(state fmt.State, verb rune) {
+	format(state, verb, s, s.Valid, s.String)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (s String) Ptr() *string {
+	return Value[string]{V: s.String, Valid: s.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (s String) ValueOrZero() string {
+	return Value[string]{V: s.String, Valid: s.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (s String) ValueOr(v string) string {
+	return Value[string]{V: s.String, Valid: s.Valid}.ValueOr(v)
+}
+
+// ToSQL returns the value as a sql.NullString.
+func (s String) ToSQL() sql.NullString {
+	return sql.NullString{String: s.String, Valid: s.Valid}
+}
+
 // IsEmpty return true if String is "" or Valid is false.
 func (s *String) IsEmpty() bool {
 	return s.String == "" || !s.Valid
@@ -77,3 +163,15 @@ func (s *String) IsEmpty() bool {
 func (s *String) IsNull() bool {
 	return !s.Valid
 }
+
+// scanString converts value, which may be a string or []byte, to a string.
+func scanString(value interface{}) (string, error) {
+	switch data := value.(type) {
+	case string:
+		return data, nil
+	case []byte:
+		return string(data), nil
+	default:
+		return "", ErrUnsupportedType
+	}
+}
@@ -2,7 +2,9 @@ package null
 
 import (
 	"bytes"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 )
@@ -70,8 +72,8 @@ func TestStringScanByteEmpty(t *testing.T) {
 func TestStringScanError(t *testing.T) {
 	val := String{}
 	err := val.Scan(struct{}{})
-	if err == nil || err.Error() != "unsupported type: struct {}" {
-		t.Fatalf("want %v, but %v:", "unsupported type: struct {}", err)
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
 	}
 }
 
@@ -188,6 +190,55 @@ func TestStringUnmarshalJSONError(t *testing.T) {
 	}
 }
 
+func TestStringMarshalText(t *testing.T) {
+	val := NewString("foo", true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `foo`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestStringMarshalTextNull(t *testing.T) {
+	val := NewString("foo", false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestStringUnmarshalText(t *testing.T) {
+	var val String
+	if err := val.UnmarshalText([]byte(`foo`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewString("foo", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestStringUnmarshalTextEmpty(t *testing.T) {
+	val := NewString("foo", true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewString("", false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
 func TestStringIsEmptyString(t *testing.T) {
 	val := NewString("foo", true)
 	if val.IsEmpty() {
@@ -220,3 +271,81 @@ func TestStringIsNull(t *testing.T) {
 		t.Fatal("it has to be not null")
 	}
 }
+
+func TestStringFrom(t *testing.T) {
+	val := StringFrom("foo")
+	want := NewString("foo", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestStringFromPtr(t *testing.T) {
+	v := "foo"
+	val := StringFromPtr(&v)
+	want := NewString("foo", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = StringFromPtr(nil)
+	want = String{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestStringPtr(t *testing.T) {
+	val := NewString("foo", true)
+	got := val.Ptr()
+	if got == nil || *got != "foo" {
+		t.Fatalf("want %v, but %v:", "foo", got)
+	}
+
+	val = NewString("foo", false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestStringValueOr(t *testing.T) {
+	val := NewString("foo", true)
+	if got := val.ValueOrZero(); got != "foo" {
+		t.Fatalf("want %v, but %v:", "foo", got)
+	}
+	if got := val.ValueOr("bar"); got != "foo" {
+		t.Fatalf("want %v, but %v:", "foo", got)
+	}
+
+	val = NewString("foo", false)
+	if got := val.ValueOrZero(); got != "" {
+		t.Fatalf("want %v, but %v:", "", got)
+	}
+	if got := val.ValueOr("bar"); got != "bar" {
+		t.Fatalf("want %v, but %v:", "bar", got)
+	}
+}
+
+func TestStringFromSQL(t *testing.T) {
+	val := StringFromSQL(sql.NullString{String: "foo", Valid: true})
+	want := NewString("foo", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = StringFromSQL(sql.NullString{String: "foo", Valid: false})
+	want = NewString("foo", false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestStringToSQL(t *testing.T) {
+	for _, valid := range []bool{true, false} {
+		got := NewString("foo", valid).ToSQL()
+		want := sql.NullString{String: "foo", Valid: valid}
+		if got != want {
+			t.Fatalf("want %v, but %v:", want, got)
+		}
+	}
+}
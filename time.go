@@ -1,12 +1,26 @@
 package null
 
 import (
+	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"log/slog"
 	"time"
 )
 
+// TimeLayouts are the layouts Time.Scan tries in order to parse a string or []byte,
+// as returned by drivers such as SQLite or MySQL without parseTime.
+// A layout without a time zone is parsed as UTC.
+var TimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
 // Time represents a bool that may be null.
 type Time struct {
 	Time  time.Time
@@ -18,55 +32,175 @@ func NewTime(t time.Time, valid bool) Time {
 	return Time{Time: t, Valid: valid}
 }
 
-// Scan implements the Scanner interface.
-func (t *Time) Scan(value interface{}) error {
-	if value == nil {
-		t.Time, t.Valid = time.Time{}, false
-		return nil
+// TimeFrom creates a new Time that is always valid.
+func TimeFrom(t time.Time) Time {
+	return NewTime(t, true)
+}
+
+// TimeFromPtr creates a new Time that is null if t is nil.
+func TimeFromPtr(t *time.Time) Time {
+	if t == nil {
+		return Time{}
 	}
+	return NewTime(*t, true)
+}
 
-	t.Valid = true
-	switch data := value.(type) {
-	case time.Time:
-		t.Time = data
-		return nil
-	default:
-		return fmt.Errorf("unsupported type: %T", value)
+// TimeFromSQL creates a new Time from a sql.NullTime.
+func TimeFromSQL(t sql.NullTime) Time {
+	return NewTime(t.Time, t.Valid)
+}
+
+// Scan implements the Scanner interface.
+// A string or []byte is parsed with TimeLayouts, and an int64 is read as Unix seconds in UTC.
+func (t *Time) Scan(value interface{}) error {
+	n := Value[time.Time]{}
+	if err := n.scan("Time", value); err != nil {
+		return err
 	}
+	t.Time, t.Valid = n.V, n.Valid
+	return nil
 }
 
 // Value implements the driver Valuer interface.
 func (t Time) Value() (driver.Value, error) {
-	if !t.Valid {
-		return nil, nil
-	}
-	return t.Time, nil
+	return Value[time.Time]{V: t.Time, Valid: t.Valid}.Value()
 }
 
 // MarshalJSON encode the value to JSON.
+// The value is encoded in RFC 3339. Use TimeLayout for another layout,
+// or UnixTime, UnixMilliTime or UnixMicroTime for a Unix timestamp.
 func (t Time) MarshalJSON() ([]byte, error) {
-	if !t.Valid {
-		return []byte("null"), nil
-	}
-	return jsonMarshal(t.Time)
+	return Value[time.Time]{V: t.Time, Valid: t.Valid}.MarshalJSON()
 }
 
 // UnmarshalJSON decode data to the value.
 func (t *Time) UnmarshalJSON(data []byte) error {
-	var tt *time.Time
-	if err := json.Unmarshal(data, &tt); err != nil {
+	n := Value[time.Time]{}
+	if err := n.unmarshalJSON("Time", data); err != nil {
 		return err
 	}
-	t.Valid = tt != nil
-	if t.Valid {
-		t.Time = *tt
-	} else {
-		t.Time = time.Time{}
+	t.Time, t.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (t Time) MarshalText() ([]byte, error) {
+	return Value[time.Time]{V: t.Time, Valid: t.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null, other text is parsed with TimeLayouts like Scan.
+func (t *Time) UnmarshalText(text []byte) error {
+	n := Value[time.Time]{}
+	if err := n.unmarshalText("Time", text); err != nil {
+		return err
+	}
+	t.Time, t.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (t Time) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, t.Valid, t)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (t *Time) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, t)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (t Time) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, t.Valid, t)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (t *Time) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (t Time) MarshalYAML() (interface{}, error) {
+	return Value[time.Time]{V: t.Time, Valid: t.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (t *Time) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[time.Time]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
 	}
+	t.Time, t.Valid = n.V, n.Valid
 	return nil
 }
 
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (t Time) LogValue() slog.Value {
+	return Value[time.Time]{V: t.Time, Valid: t.Valid}.LogValue()
+}
+
+// Format implements the fmt.Formatter interface.
+func (t Time) Format(state fmt.State, verb rune) {
+	format(state, verb, t, t.Valid, t.Time)
+}
+
+// String implements the fmt.Stringer interface.
+func (t Time) String() string {
+	return fmt.Sprint(t)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (t Time) Ptr() *time.Time {
+	return Value[time.Time]{V: t.Time, Valid: t.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (t Time) ValueOrZero() time.Time {
+	return Value[time.Time]{V: t.Time, Valid: t.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (t Time) ValueOr(v time.Time) time.Time {
+	return Value[time.Time]{V: t.Time, Valid: t.Valid}.ValueOr(v)
+}
+
+// ToSQL returns the value as a sql.NullTime.
+func (t Time) ToSQL() sql.NullTime {
+	return sql.NullTime{Time: t.Time, Valid: t.Valid}
+}
+
 // IsNull returns true if Valid is false.
 func (s *Time) IsNull() bool {
 	return !s.Valid
 }
+
+// scanTime converts value to a time.Time. A string or []byte is parsed with TimeLayouts,
+// and an int64 is read as Unix seconds in UTC.
+func scanTime(value interface{}) (time.Time, error) {
+	switch data := value.(type) {
+	case time.Time:
+		return data, nil
+	case string:
+		return parseTime(data)
+	case []byte:
+		return parseTime(string(data))
+	case int64:
+		return time.Unix(data, 0).UTC(), nil
+	default:
+		return time.Time{}, ErrUnsupportedType
+	}
+}
+
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range TimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time: %q, tried layouts %q", s, TimeLayouts)
+}
@@ -0,0 +1,83 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Layout provides the layout a TimeLayout uses for the JSON string, e.g.
+//
+//	type DateTime struct{}
+//
+//	func (DateTime) Layout() string { return time.DateTime }
+type Layout interface {
+	Layout() string
+}
+
+// TimeLayout represents a time that may be null and is encoded in JSON as a string
+// in the layout of L, so that fields with different layouts can coexist.
+// It behaves like Time in every other respect.
+type TimeLayout[L Layout] struct {
+	Time
+}
+
+// NewTimeLayout creates a new TimeLayout
+func NewTimeLayout[L Layout](t time.Time, valid bool) TimeLayout[L] {
+	return TimeLayout[L]{Time: NewTime(t, valid)}
+}
+
+// TimeLayoutFrom creates a new TimeLayout that is always valid.
+func TimeLayoutFrom[L Layout](t time.Time) TimeLayout[L] {
+	return NewTimeLayout[L](t, true)
+}
+
+// TimeLayoutFromPtr creates a new TimeLayout that is null if t is nil.
+func TimeLayoutFromPtr[L Layout](t *time.Time) TimeLayout[L] {
+	return TimeLayout[L]{Time: TimeFromPtr(t)}
+}
+
+// MarshalJSON encode the value to JSON.
+func (t TimeLayout[L]) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	var l L
+	return marshalJSONString(t.Time.Time.Format(l.Layout())), nil
+}
+
+// UnmarshalJSON decode data to the value.
+// The string is parsed with the layout of L.
+func (t *TimeLayout[L]) UnmarshalJSON(data []byte) error {
+	str, valid, ok := decodeJSONString(data)
+	if !ok {
+		var s *string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		if s != nil {
+			str, valid = *s, true
+		}
+	}
+	if !valid {
+		t.Time.Time, t.Valid = time.Time{}, false
+		return nil
+	}
+	var l L
+	tt, err := time.Parse(l.Layout(), str)
+	if err != nil {
+		return err
+	}
+	t.Time.Time, t.Valid = tt, true
+	return nil
+}
+
+// Format implements the fmt.Formatter interface.
+func (t TimeLayout[L]) Format(state fmt.State, verb rune) {
+	format(state, verb, t, t.Valid, t.Time.Time)
+}
+
+// String implements the fmt.Stringer interface.
+func (t TimeLayout[L]) String() string {
+	return fmt.Sprint(t)
+}
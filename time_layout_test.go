@@ -0,0 +1,116 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+type dateTimeLayout struct{}
+
+func (dateTimeLayout) Layout() string { return time.DateTime }
+
+type kitchenLayout struct{}
+
+func (kitchenLayout) Layout() string { return time.Kitchen }
+
+func TestTimeLayoutMarshalJSON(t *testing.T) {
+	val := struct {
+		Created TimeLayout[dateTimeLayout] `json:"created"`
+		Alarm   TimeLayout[kitchenLayout]  `json:"alarm"`
+		Updated Time                       `json:"updated"`
+	}{
+		Created: NewTimeLayout[dateTimeLayout](testTime, true),
+		Alarm:   NewTimeLayout[kitchenLayout](testTime, true),
+		Updated: NewTime(testTime, true),
+	}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"created":"2022-12-31 23:59:59","alarm":"11:59PM","updated":"2022-12-31T23:59:59Z"}`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestTimeLayoutMarshalJSONNull(t *testing.T) {
+	got, err := TimeLayout[dateTimeLayout]{}.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "null" {
+		t.Fatalf("want %v, but %v:", "null", string(got))
+	}
+}
+
+func TestTimeLayoutUnmarshalJSON(t *testing.T) {
+	val := TimeLayout[dateTimeLayout]{}
+	if err := val.UnmarshalJSON([]byte(`"2022-12-31 23:59:59"`)); err != nil {
+		t.Fatal(err)
+	}
+	want := NewTimeLayout[dateTimeLayout](testTime, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	if err := val.UnmarshalJSON([]byte("null")); err != nil {
+		t.Fatal(err)
+	}
+	if val != (TimeLayout[dateTimeLayout]{}) {
+		t.Fatalf("want %v, but %v:", TimeLayout[dateTimeLayout]{}, val)
+	}
+}
+
+func TestTimeLayoutUnmarshalJSONError(t *testing.T) {
+	tests := []string{
+		`"2022-12-31T23:59:59Z"`,
+		`1672531199`,
+		`foo`,
+	}
+	for _, tt := range tests {
+		val := TimeLayout[dateTimeLayout]{}
+		if err := val.UnmarshalJSON([]byte(tt)); err == nil {
+			t.Fatalf("no error message is output: %s", tt)
+		}
+	}
+}
+
+func TestTimeLayoutScan(t *testing.T) {
+	val := TimeLayout[dateTimeLayout]{}
+	if err := val.Scan(testTime); err != nil {
+		t.Fatal(err)
+	}
+	got, err := val.Value()
+	if got != testTime || err != nil {
+		t.Fatalf("want %v, but %v:", testTime, got)
+	}
+}
+
+func TestTimeLayoutFormat(t *testing.T) {
+	val := NewTimeLayout[dateTimeLayout](testTime, true)
+	if got := fmt.Sprint(val); got != fmt.Sprint(testTime) {
+		t.Fatalf("want %v, but %v:", testTime, got)
+	}
+
+	want := fmt.Sprintf("%T{Time:%#v}", val, val.Time)
+	if got := fmt.Sprintf("%#v", val); got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestTimeLayoutFromPtr(t *testing.T) {
+	if got := TimeLayoutFromPtr[dateTimeLayout](nil); got != (TimeLayout[dateTimeLayout]{}) {
+		t.Fatalf("want %v, but %v:", TimeLayout[dateTimeLayout]{}, got)
+	}
+
+	tt := testTime
+	if got, want := TimeLayoutFromPtr[dateTimeLayout](&tt), TimeLayoutFrom[dateTimeLayout](testTime); got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
@@ -0,0 +1,265 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+const timeOfDayLayout = "15:04:05.999999999"
+
+// TimeOfDay represents a time of day that may be null, such as a TIME column.
+// It has no date and no time zone, so two TimeOfDays can be compared with ==.
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+	Valid      bool
+}
+
+// NewTimeOfDay creates a new TimeOfDay
+func NewTimeOfDay(hour, minute, second, nanosecond int, valid bool) TimeOfDay {
+	return TimeOfDay{Hour: hour, Minute: minute, Second: second, Nanosecond: nanosecond, Valid: valid}
+}
+
+// TimeOfDayOf returns the time of day of t in the location of t.
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	hour, minute, second := t.Clock()
+	return TimeOfDay{Hour: hour, Minute: minute, Second: second, Nanosecond: t.Nanosecond(), Valid: true}
+}
+
+// TimeOfDayFrom creates a new TimeOfDay that is always valid, from the time of day of t in the location of t.
+func TimeOfDayFrom(t time.Time) TimeOfDay {
+	return TimeOfDayOf(t)
+}
+
+// TimeOfDayFromPtr returns the time of day of *t in the location of *t, or null if t is nil.
+func TimeOfDayFromPtr(t *time.Time) TimeOfDay {
+	if t == nil {
+		return TimeOfDay{}
+	}
+	return TimeOfDayOf(*t)
+}
+
+// Scan implements the Scanner interface.
+// A time.Time is read in its own location. Text is read in the form "15:04:05",
+// optionally with fractional seconds.
+func (t *TimeOfDay) Scan(value interface{}) error {
+	if value == nil {
+		*t = TimeOfDay{}
+		return nil
+	}
+
+	switch data := value.(type) {
+	case time.Time:
+		*t = TimeOfDayOf(data)
+		return nil
+	case string:
+		tod, err := parseTimeOfDay(data)
+		if err != nil {
+			return newScanError("TimeOfDay", value, err)
+		}
+		*t = tod
+		return nil
+	case []byte:
+		tod, err := parseTimeOfDay(string(data))
+		if err != nil {
+			return newScanError("TimeOfDay", value, err)
+		}
+		*t = tod
+		return nil
+	default:
+		return newScanError("TimeOfDay", value, ErrUnsupportedType)
+	}
+}
+
+// Value implements the driver Valuer interface.
+// The value is a string in the form "15:04:05", with fractional seconds if any.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.format(), nil
+}
+
+// MarshalJSON encode the value to JSON.
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	b := make([]byte, 0, len(timeOfDayLayout)+2)
+	b = append(b, '"')
+	b = t.appendFormat(b)
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON decode data to the value.
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	var str *string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	if str == nil {
+		*t = TimeOfDay{}
+		return nil
+	}
+	return t.Scan(*str)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return []byte{}, nil
+	}
+	return []byte(t.format()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*t = TimeOfDay{}
+		return nil
+	}
+	return t.Scan(string(text))
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (t TimeOfDay) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, t.Valid, t)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (t *TimeOfDay) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, t)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (t TimeOfDay) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, t.Valid, t)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (t *TimeOfDay) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (t TimeOfDay) MarshalYAML() (interface{}, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.format(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (t *TimeOfDay) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str *string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if str == nil {
+		*t = TimeOfDay{}
+		return nil
+	}
+	return t.Scan(*str)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (t TimeOfDay) LogValue() slog.Value {
+	if !t.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(t.format())
+}
+
+// Format implements the fmt.Formatter interface.
+func (t TimeOfDay) Format(state fmt.State, verb rune) {
+	format(state, verb, t, t.Valid, t.format())
+}
+
+// String implements the fmt.Stringer interface.
+func (t TimeOfDay) String() string {
+	return fmt.Sprint(t)
+}
+
+// Ptr returns a pointer to the time.Time at the time of day on January 1, year 1, UTC,
+// the date of the zero time.Time, or nil if it is null.
+func (t TimeOfDay) Ptr() *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.On(zeroDate, time.UTC)
+	return &v
+}
+
+// ValueOrZero returns the time.Time at the time of day on January 1, year 1, UTC,
+// or the zero time.Time if it is null.
+func (t TimeOfDay) ValueOrZero() time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.On(zeroDate, time.UTC)
+}
+
+// ValueOr returns the time.Time at the time of day on January 1, year 1, UTC, or v if it is null.
+func (t TimeOfDay) ValueOr(v time.Time) time.Time {
+	if !t.Valid {
+		return v
+	}
+	return t.On(zeroDate, time.UTC)
+}
+
+// On returns the time.Time at the time of day on the date d in loc.
+func (t TimeOfDay) On(d Date, loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, t.Hour, t.Minute, t.Second, t.Nanosecond, loc)
+}
+
+// Before reports whether t is before t2.
+func (t TimeOfDay) Before(t2 TimeOfDay) bool {
+	return t.sinceMidnight() < t2.sinceMidnight()
+}
+
+// After reports whether t is after t2.
+func (t TimeOfDay) After(t2 TimeOfDay) bool {
+	return t.sinceMidnight() > t2.sinceMidnight()
+}
+
+// IsNull returns true if Valid is false.
+func (t *TimeOfDay) IsNull() bool {
+	return !t.Valid
+}
+
+// zeroDate is the date of the zero time.Time.
+var zeroDate = DateOf(time.Time{})
+
+func (t TimeOfDay) sinceMidnight() time.Duration {
+	return time.Duration(t.Hour)*time.Hour + time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second + time.Duration(t.Nanosecond)
+}
+
+func (t TimeOfDay) format() string {
+	return string(t.appendFormat(make([]byte, 0, len(timeOfDayLayout))))
+}
+
+// appendFormat appends the time of day in the form "15:04:05.999999999" to dst.
+func (t TimeOfDay) appendFormat(dst []byte) []byte {
+	return time.Date(0, 1, 1, t.Hour, t.Minute, t.Second, t.Nanosecond, time.UTC).AppendFormat(dst, timeOfDayLayout)
+}
+
+func parseTimeOfDay(s string) (TimeOfDay, error) {
+	t, err := time.Parse(timeOfDayLayout, s)
+	if err != nil {
+		return TimeOfDay{}, fmt.Errorf("invalid time of day: %q", s)
+	}
+	return TimeOfDayOf(t), nil
+}
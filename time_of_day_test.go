@@ -0,0 +1,283 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTimeOfDayScanNull(t *testing.T) {
+	val := NewTimeOfDay(1, 2, 3, 4, true)
+	if err := val.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewTimeOfDay(0, 0, 0, 0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeOfDayScanTime(t *testing.T) {
+	val := TimeOfDay{}
+	tokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+	if err := val.Scan(time.Date(2022, 1, 2, 3, 4, 5, 6, tokyo)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewTimeOfDay(3, 4, 5, 6, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeOfDayScanString(t *testing.T) {
+	val := TimeOfDay{}
+	if err := val.Scan("23:59:59"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewTimeOfDay(23, 59, 59, 0, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeOfDayScanByte(t *testing.T) {
+	val := TimeOfDay{}
+	if err := val.Scan([]byte("15:04:05.123456")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewTimeOfDay(15, 4, 5, 123456000, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeOfDayScanStringParseError(t *testing.T) {
+	for _, in := range []string{"", "foo", "24:00:00", "15:04", "15:04:05+09", "-01:00:00"} {
+		val := TimeOfDay{}
+		err := val.Scan(in)
+		if err == nil || err.Error() != `cannot scan string into TimeOfDay: invalid time of day: "`+in+`"` {
+			t.Fatalf("want %v, but %v:", `cannot scan string into TimeOfDay: invalid time of day: "`+in+`"`, err)
+		}
+	}
+}
+
+func TestTimeOfDayScanTypeError(t *testing.T) {
+	val := TimeOfDay{}
+	err := val.Scan(struct{}{})
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+}
+
+func TestTimeOfDayValue(t *testing.T) {
+	val := NewTimeOfDay(15, 4, 5, 0, true)
+	got, err := val.Value()
+	if got != "15:04:05" || err != nil {
+		t.Fatalf("want %v, but %v:", "15:04:05", got)
+	}
+}
+
+func TestTimeOfDayValueFraction(t *testing.T) {
+	val := NewTimeOfDay(15, 4, 5, 120000000, true)
+	got, err := val.Value()
+	if got != "15:04:05.12" || err != nil {
+		t.Fatalf("want %v, but %v:", "15:04:05.12", got)
+	}
+}
+
+func TestTimeOfDayValueNull(t *testing.T) {
+	val := NewTimeOfDay(15, 4, 5, 0, false)
+	got, err := val.Value()
+	if got != nil || err != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestTimeOfDayMarshalJSON(t *testing.T) {
+	val := NewTimeOfDay(1, 2, 3, 0, true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `"01:02:03"`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestTimeOfDayMarshalJSONNull(t *testing.T) {
+	val := NewTimeOfDay(1, 2, 3, 0, false)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "null"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestTimeOfDayUnmarshalJSON(t *testing.T) {
+	var val TimeOfDay
+	err := json.NewDecoder(strings.NewReader(`"01:02:03.5"`)).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewTimeOfDay(1, 2, 3, 500000000, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeOfDayUnmarshalJSONNull(t *testing.T) {
+	val := NewTimeOfDay(1, 2, 3, 0, true)
+	err := json.NewDecoder(strings.NewReader("null")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewTimeOfDay(0, 0, 0, 0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeOfDayUnmarshalJSONError(t *testing.T) {
+	for _, data := range []string{"foo", `"foo"`, "10"} {
+		val := TimeOfDay{}
+		if err := val.UnmarshalJSON([]byte(data)); err == nil {
+			t.Fatalf("no error message is output: %v", data)
+		}
+	}
+}
+
+func TestTimeOfDayMarshalText(t *testing.T) {
+	val := NewTimeOfDay(1, 2, 3, 0, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "01:02:03"
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestTimeOfDayUnmarshalTextEmpty(t *testing.T) {
+	val := NewTimeOfDay(1, 2, 3, 0, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewTimeOfDay(0, 0, 0, 0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeOfDayOn(t *testing.T) {
+	val := NewTimeOfDay(1, 2, 3, 4, true)
+	got := val.On(NewDate(2022, time.January, 2, true), time.UTC)
+	want := time.Date(2022, 1, 2, 1, 2, 3, 4, time.UTC)
+	if !got.Equal(want) {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestTimeOfDayBeforeAfter(t *testing.T) {
+	t1 := NewTimeOfDay(23, 59, 59, 999999999, true)
+	t2 := NewTimeOfDay(0, 0, 0, 0, true)
+	if !t2.Before(t1) || t1.Before(t2) || t1.Before(t1) {
+		t.Fatal("Before is wrong")
+	}
+	if !t1.After(t2) || t2.After(t1) || t1.After(t1) {
+		t.Fatal("After is wrong")
+	}
+}
+
+func TestTimeOfDayIsNull(t *testing.T) {
+	val := NewTimeOfDay(0, 0, 0, 0, true)
+	if val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+
+	val = NewTimeOfDay(0, 0, 0, 0, false)
+	if !val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+}
+
+func TestTimeOfDayFromPtr(t *testing.T) {
+	v := time.Date(2022, 1, 2, 3, 4, 5, 6, time.UTC)
+	val := TimeOfDayFromPtr(&v)
+	want := NewTimeOfDay(3, 4, 5, 6, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = TimeOfDayFromPtr(nil)
+	want = TimeOfDay{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeOfDayFrom(t *testing.T) {
+	loc := time.FixedZone("UTC+9", 9*60*60)
+	val := TimeOfDayFrom(time.Date(2022, 1, 2, 3, 4, 5, 6, loc))
+	want := NewTimeOfDay(3, 4, 5, 6, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeOfDayPtr(t *testing.T) {
+	want := time.Date(1, 1, 1, 3, 4, 5, 6, time.UTC)
+	if got := NewTimeOfDay(3, 4, 5, 6, true).Ptr(); got == nil || !got.Equal(want) {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+	if got := NewTimeOfDay(3, 4, 5, 6, false).Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+
+	val := NewTimeOfDay(3, 4, 5, 6, true)
+	if got := TimeOfDayFromPtr(val.Ptr()); got != val {
+		t.Fatalf("want %v, but %v:", val, got)
+	}
+}
+
+func TestTimeOfDayValueOrZero(t *testing.T) {
+	want := time.Date(1, 1, 1, 3, 4, 5, 6, time.UTC)
+	if got := NewTimeOfDay(3, 4, 5, 6, true).ValueOrZero(); !got.Equal(want) {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+	if got := NewTimeOfDay(3, 4, 5, 6, false).ValueOrZero(); !got.IsZero() {
+		t.Fatalf("want %v, but %v:", time.Time{}, got)
+	}
+	if got := NewTimeOfDay(0, 0, 0, 0, true).ValueOrZero(); !got.IsZero() {
+		t.Fatalf("want %v, but %v:", time.Time{}, got)
+	}
+}
+
+func TestTimeOfDayValueOr(t *testing.T) {
+	want := time.Date(1, 1, 1, 3, 4, 5, 6, time.UTC)
+	if got := NewTimeOfDay(3, 4, 5, 6, true).ValueOr(testTime); !got.Equal(want) {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+	if got := NewTimeOfDay(3, 4, 5, 6, false).ValueOr(testTime); !got.Equal(testTime) {
+		t.Fatalf("want %v, but %v:", testTime, got)
+	}
+}
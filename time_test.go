@@ -2,7 +2,9 @@ package null
 
 import (
 	"bytes"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -34,11 +36,88 @@ func TestTimeScanTime(t *testing.T) {
 	}
 }
 
+func TestTimeScanString(t *testing.T) {
+	tokyo := time.FixedZone("", 9*60*60)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"2022-12-31T23:59:59Z", testTime},
+		{"2022-12-31T23:59:59.123456+09:00", time.Date(2022, 12, 31, 23, 59, 59, 123456000, tokyo)},
+		{"2022-12-31 23:59:59+09:00", time.Date(2022, 12, 31, 23, 59, 59, 0, tokyo)},
+		{"2022-12-31 23:59:59.5+09", time.Date(2022, 12, 31, 23, 59, 59, 500000000, tokyo)},
+		{"2022-12-31 23:59:59", testTime},
+		{"2022-12-31T23:59:59", testTime},
+		{"2022-12-31", time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		val := Time{}
+		if err := val.Scan(tt.in); err != nil {
+			t.Fatal(err)
+		}
+
+		if !val.Valid || !val.Time.Equal(tt.want) {
+			t.Fatalf("want %v, but %v:", tt.want, val)
+		}
+	}
+}
+
+func TestTimeScanByte(t *testing.T) {
+	val := Time{}
+	if err := val.Scan([]byte("2022-12-31 23:59:59")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewTime(testTime, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeScanInt64(t *testing.T) {
+	val := Time{}
+	if err := val.Scan(testTime.Unix()); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewTime(testTime, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeScanLayouts(t *testing.T) {
+	defer func(layouts []string) { TimeLayouts = layouts }(TimeLayouts)
+	TimeLayouts = []string{"02/01/2006"}
+
+	val := Time{}
+	if err := val.Scan("31/12/2022"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewTime(time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeScanStringParseError(t *testing.T) {
+	defer func(layouts []string) { TimeLayouts = layouts }(TimeLayouts)
+	TimeLayouts = []string{time.RFC3339, time.DateOnly}
+
+	val := Time{}
+	err := val.Scan("foo")
+	want := `cannot scan string into Time: invalid time: "foo", tried layouts ["2006-01-02T15:04:05Z07:00" "2006-01-02"]`
+	if err == nil || err.Error() != want {
+		t.Fatalf("want %v, but %v:", want, err)
+	}
+}
+
 func TestTimeScanTypeError(t *testing.T) {
 	val := Time{}
 	err := val.Scan(struct{}{})
-	if err == nil || err.Error() != "unsupported type: struct {}" {
-		t.Fatalf("want %v, but %v:", "unsupported type: struct {}", err)
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
 	}
 }
 
@@ -120,6 +199,75 @@ func TestTimeUnmarshalJSONError(t *testing.T) {
 	}
 }
 
+func TestTimeMarshalText(t *testing.T) {
+	val := NewTime(time.Date(2022, 1, 2, 3, 4, 5, 6, time.UTC), true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `2022-01-02T03:04:05.000000006Z`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestTimeMarshalTextNull(t *testing.T) {
+	val := NewTime(time.Now(), false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestTimeUnmarshalText(t *testing.T) {
+	var val Time
+	if err := val.UnmarshalText([]byte(`2022-01-02T03:04:05.000000006Z`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewTime(time.Date(2022, 1, 2, 3, 4, 5, 6, time.UTC), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeUnmarshalTextLayouts(t *testing.T) {
+	var val Time
+	if err := val.UnmarshalText([]byte("2022-01-02 15:04:05")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewTime(time.Date(2022, 1, 2, 15, 4, 5, 0, time.UTC), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeUnmarshalTextEmpty(t *testing.T) {
+	val := NewTime(time.Now(), true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewTime(time.Time{}, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeUnmarshalTextError(t *testing.T) {
+	val := Time{}
+	err := val.UnmarshalText([]byte("foo"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
 func TestTimeIsNull(t *testing.T) {
 	val := NewTime(time.Time{}, true)
 	if val.IsNull() {
@@ -131,3 +279,81 @@ func TestTimeIsNull(t *testing.T) {
 		t.Fatal("it has to be not null")
 	}
 }
+
+func TestTimeFrom(t *testing.T) {
+	val := TimeFrom(testTime)
+	want := NewTime(testTime, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeFromPtr(t *testing.T) {
+	v := testTime
+	val := TimeFromPtr(&v)
+	want := NewTime(testTime, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = TimeFromPtr(nil)
+	want = Time{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimePtr(t *testing.T) {
+	val := NewTime(testTime, true)
+	got := val.Ptr()
+	if got == nil || *got != testTime {
+		t.Fatalf("want %v, but %v:", testTime, got)
+	}
+
+	val = NewTime(testTime, false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestTimeValueOr(t *testing.T) {
+	val := NewTime(testTime, true)
+	if got := val.ValueOrZero(); got != testTime {
+		t.Fatalf("want %v, but %v:", testTime, got)
+	}
+	if got := val.ValueOr(testTime.Add(time.Hour)); got != testTime {
+		t.Fatalf("want %v, but %v:", testTime, got)
+	}
+
+	val = NewTime(testTime, false)
+	if got := val.ValueOrZero(); got != (time.Time{}) {
+		t.Fatalf("want %v, but %v:", time.Time{}, got)
+	}
+	if got := val.ValueOr(testTime.Add(time.Hour)); got != testTime.Add(time.Hour) {
+		t.Fatalf("want %v, but %v:", testTime.Add(time.Hour), got)
+	}
+}
+
+func TestTimeFromSQL(t *testing.T) {
+	val := TimeFromSQL(sql.NullTime{Time: testTime, Valid: true})
+	want := NewTime(testTime, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = TimeFromSQL(sql.NullTime{Time: testTime, Valid: false})
+	want = NewTime(testTime, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestTimeToSQL(t *testing.T) {
+	for _, valid := range []bool{true, false} {
+		got := NewTime(testTime, valid).ToSQL()
+		want := sql.NullTime{Time: testTime, Valid: valid}
+		if got != want {
+			t.Fatalf("want %v, but %v:", want, got)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+)
+
+// Uint represents a uint that may be null.
+// Since driver.Value cannot hold a uint, Value returns values greater than
+// math.MaxInt64 as a decimal string.
+type Uint struct {
+	Uint  uint
+	Valid bool
+}
+
+// NewUint creates a new Uint
+func NewUint(ui uint, valid bool) Uint {
+	return Uint{Uint: ui, Valid: valid}
+}
+
+// UintFrom creates a new Uint that is always valid.
+func UintFrom(u uint) Uint {
+	return NewUint(u, true)
+}
+
+// UintFromPtr creates a new Uint that is null if u is nil.
+func UintFromPtr(u *uint) Uint {
+	if u == nil {
+		return Uint{}
+	}
+	return NewUint(*u, true)
+}
+
+// Scan implements the Scanner interface.
+// Numbers of any integer type and floats with no fractional part are accepted,
+// and a number out of the range of Uint is reported as an *OverflowError.
+func (u *Uint) Scan(value interface{}) error {
+	n := Value[uint]{}
+	if err := n.scan("Uint", value); err != nil {
+		return err
+	}
+	u.Uint, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (u Uint) Value() (driver.Value, error) {
+	return Value[uint]{V: u.Uint, Valid: u.Valid}.Value()
+}
+
+// MarshalJSON encode the value to JSON.
+func (u Uint) MarshalJSON() ([]byte, error) {
+	return Value[uint]{V: u.Uint, Valid: u.Valid}.MarshalJSON()
+}
+
+// UnmarshalJSON decode data to the value.
+// A quoted value is accepted as well if LenientJSON is true.
+func (u *Uint) UnmarshalJSON(data []byte) error {
+	n := Value[uint]{}
+	if err := n.unmarshalJSON("Uint", data); err != nil {
+		return err
+	}
+	u.Uint, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (u Uint) MarshalText() ([]byte, error) {
+	return Value[uint]{V: u.Uint, Valid: u.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (u *Uint) UnmarshalText(text []byte) error {
+	n := Value[uint]{}
+	if err := n.unmarshalText("Uint", text); err != nil {
+		return err
+	}
+	u.Uint, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (u Uint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, u.Valid, u)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (u *Uint) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, u)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (u Uint) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, u.Valid, u)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (u *Uint) UnmarshalXMLAttr(attr xml.Attr) error {
+	return u.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (u Uint) MarshalYAML() (interface{}, error) {
+	return Value[uint]{V: u.Uint, Valid: u.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (u *Uint) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[uint]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
+	}
+	u.Uint, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (u Uint) LogValue() slog.Value {
+	return Value[uint]{V: u.Uint, Valid: u.Valid}.LogValue()
+}
+
+// Format implements the fmt.Formatter interface.
+func (u Uint) Format(state fmt.State, verb rune) {
+	format(state, verb, u, u.Valid, u.Uint)
+}
+
+// String implements the fmt.Stringer interface.
+func (u Uint) String() string {
+	return fmt.Sprint(u)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (u Uint) Ptr() *uint {
+	return Value[uint]{V: u.Uint, Valid: u.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (u Uint) ValueOrZero() uint {
+	return Value[uint]{V: u.Uint, Valid: u.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (u Uint) ValueOr(v uint) uint {
+	return Value[uint]{V: u.Uint, Valid: u.Valid}.ValueOr(v)
+}
+
+// IsNull returns true if Valid is false.
+func (u *Uint) IsNull() bool {
+	return !u.Valid
+}
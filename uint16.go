@@ -0,0 +1,156 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+)
+
+// Uint16 represents a uint16 that may be null.
+type Uint16 struct {
+	Uint16 uint16
+	Valid  bool
+}
+
+// NewUint16 creates a new Uint16
+func NewUint16(u16 uint16, valid bool) Uint16 {
+	return Uint16{Uint16: u16, Valid: valid}
+}
+
+// Uint16From creates a new Uint16 that is always valid.
+func Uint16From(u uint16) Uint16 {
+	return NewUint16(u, true)
+}
+
+// Uint16FromPtr creates a new Uint16 that is null if u is nil.
+func Uint16FromPtr(u *uint16) Uint16 {
+	if u == nil {
+		return Uint16{}
+	}
+	return NewUint16(*u, true)
+}
+
+// Scan implements the Scanner interface.
+// Numbers of any integer type and floats with no fractional part are accepted,
+// and a number out of the range of Uint16 is reported as an *OverflowError.
+func (u *Uint16) Scan(value interface{}) error {
+	n := Value[uint16]{}
+	if err := n.scan("Uint16", value); err != nil {
+		return err
+	}
+	u.Uint16, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (u Uint16) Value() (driver.Value, error) {
+	return Value[uint16]{V: u.Uint16, Valid: u.Valid}.Value()
+}
+
+// MarshalJSON encode the value to JSON.
+func (u Uint16) MarshalJSON() ([]byte, error) {
+	return Value[uint16]{V: u.Uint16, Valid: u.Valid}.MarshalJSON()
+}
+
+// UnmarshalJSON decode data to the value.
+// A quoted value is accepted as well if LenientJSON is true.
+func (u *Uint16) UnmarshalJSON(data []byte) error {
+	n := Value[uint16]{}
+	if err := n.unmarshalJSON("Uint16", data); err != nil {
+		return err
+	}
+	u.Uint16, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (u Uint16) MarshalText() ([]byte, error) {
+	return Value[uint16]{V: u.Uint16, Valid: u.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (u *Uint16) UnmarshalText(text []byte) error {
+	n := Value[uint16]{}
+	if err := n.unmarshalText("Uint16", text); err != nil {
+		return err
+	}
+	u.Uint16, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (u Uint16) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, u.Valid, u)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (u *Uint16) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, u)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (u Uint16) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, u.Valid, u)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (u *Uint16) UnmarshalXMLAttr(attr xml.Attr) error {
+	return u.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (u Uint16) MarshalYAML() (interface{}, error) {
+	return Value[uint16]{V: u.Uint16, Valid: u.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (u *Uint16) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[uint16]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
+	}
+	u.Uint16, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (u Uint16) LogValue() slog.Value {
+	return Value[uint16]{V: u.Uint16, Valid: u.Valid}.LogValue()
+}
+
+// Format implements the fmt.Formatter interface.
+func (u Uint16) Format(state fmt.State, verb rune) {
+	format(state, verb, u, u.Valid, u.Uint16)
+}
+
+// String implements the fmt.Stringer interface.
+func (u Uint16) String() string {
+	return fmt.Sprint(u)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (u Uint16) Ptr() *uint16 {
+	return Value[uint16]{V: u.Uint16, Valid: u.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (u Uint16) ValueOrZero() uint16 {
+	return Value[uint16]{V: u.Uint16, Valid: u.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (u Uint16) ValueOr(v uint16) uint16 {
+	return Value[uint16]{V: u.Uint16, Valid: u.Valid}.ValueOr(v)
+}
+
+// IsNull returns true if Valid is false.
+func (u *Uint16) IsNull() bool {
+	return !u.Valid
+}
@@ -0,0 +1,376 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestUint16ScanNull(t *testing.T) {
+	val := Uint16{}
+	if err := val.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint16(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint16ScanString(t *testing.T) {
+	val := Uint16{}
+	if err := val.Scan("1"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint16(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint16ScanByte(t *testing.T) {
+	val := Uint16{}
+	if err := val.Scan([]byte("1")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint16(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint16ScanInt(t *testing.T) {
+	val := Uint16{}
+	var i int = 1
+	if err := val.Scan(i); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint16(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint16ScanIntNegative(t *testing.T) {
+	val := Uint16{}
+	var i int = -1
+	err := val.Scan(i)
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
+	}
+}
+
+func TestUint16ScanInt64(t *testing.T) {
+	val := Uint16{}
+	var i64 int64 = 1
+	if err := val.Scan(i64); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint16(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint16ScanUint8(t *testing.T) {
+	val := Uint16{}
+	var u8 uint8 = 1
+	if err := val.Scan(u8); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint16(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint16ScanUint64(t *testing.T) {
+	val := Uint16{}
+	var u64 uint64 = 1
+	if err := val.Scan(u64); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint16(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint16ScanMaximumValueOver(t *testing.T) {
+	val := Uint16{}
+	var u64 uint64 = math.MaxUint16 + 1
+	err := val.Scan(u64)
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
+	}
+}
+
+func TestUint16ScanStringParseError(t *testing.T) {
+	val := Uint16{}
+	err := val.Scan("-1")
+	if err == nil {
+		t.Fatalf("no error is output")
+	}
+}
+
+func TestUint16ScanStringByteError(t *testing.T) {
+	val := Uint16{}
+	err := val.Scan([]byte("foo"))
+	if err == nil {
+		t.Fatalf("no error is output")
+	}
+}
+
+func TestUint16ScanTypeError(t *testing.T) {
+	val := Uint16{}
+	err := val.Scan(struct{}{})
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+}
+
+func TestUint16ValueInt(t *testing.T) {
+	val := NewUint16(1, true)
+	got, err := val.Value()
+	if got != int64(1) || err != nil {
+		t.Fatalf("want %v, but %v:", "1", got)
+	}
+}
+
+func TestUint16ValueZero(t *testing.T) {
+	val := NewUint16(0, true)
+	got, err := val.Value()
+	if got != int64(0) || err != nil {
+		t.Fatalf("want %v, but %v:", 0, got)
+	}
+}
+
+func TestUint16ValueNull(t *testing.T) {
+	val := NewUint16(0, false)
+	got, err := val.Value()
+	if got != nil || err != nil {
+		t.Fatalf("want %v, but %v:", 0, got)
+	}
+}
+
+func TestUint16MarshalJSONInt(t *testing.T) {
+	val := NewUint16(1, true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "1"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUint16MarshalJSONZero(t *testing.T) {
+	val := NewUint16(0, true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "0"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUint16MarshalJSONNull(t *testing.T) {
+	val := NewUint16(0, false)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "null"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUint16UnmarshalJSONInt(t *testing.T) {
+	var val Uint16
+	err := json.NewDecoder(strings.NewReader("1")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint16(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint16UnmarshalJSONZero(t *testing.T) {
+	var val Uint16
+	err := json.NewDecoder(strings.NewReader("0")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint16(0, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint16UnmarshalJSONNull(t *testing.T) {
+	var val Uint16
+	err := json.NewDecoder(strings.NewReader("null")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint16(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint16UnmarshalJSONError(t *testing.T) {
+	val := Uint16{}
+	err := val.UnmarshalJSON([]byte("-1"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestUint16MarshalText(t *testing.T) {
+	val := NewUint16(1, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `1`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestUint16MarshalTextNull(t *testing.T) {
+	val := NewUint16(1, false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestUint16UnmarshalText(t *testing.T) {
+	var val Uint16
+	if err := val.UnmarshalText([]byte(`1`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint16(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint16UnmarshalTextEmpty(t *testing.T) {
+	val := NewUint16(1, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint16(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint16UnmarshalTextError(t *testing.T) {
+	val := Uint16{}
+	err := val.UnmarshalText([]byte("-1"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestUint16IsNull(t *testing.T) {
+	val := NewUint16(0, true)
+	if val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+
+	val = NewUint16(0, false)
+	if !val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+}
+
+func TestUint16From(t *testing.T) {
+	val := Uint16From(uint16(1))
+	want := NewUint16(uint16(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint16FromPtr(t *testing.T) {
+	v := uint16(1)
+	val := Uint16FromPtr(&v)
+	want := NewUint16(uint16(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = Uint16FromPtr(nil)
+	want = Uint16{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint16Ptr(t *testing.T) {
+	val := NewUint16(uint16(1), true)
+	got := val.Ptr()
+	if got == nil || *got != uint16(1) {
+		t.Fatalf("want %v, but %v:", uint16(1), got)
+	}
+
+	val = NewUint16(uint16(1), false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestUint16ValueOr(t *testing.T) {
+	val := NewUint16(uint16(1), true)
+	if got := val.ValueOrZero(); got != uint16(1) {
+		t.Fatalf("want %v, but %v:", uint16(1), got)
+	}
+	if got := val.ValueOr(uint16(2)); got != uint16(1) {
+		t.Fatalf("want %v, but %v:", uint16(1), got)
+	}
+
+	val = NewUint16(uint16(1), false)
+	if got := val.ValueOrZero(); got != uint16(0) {
+		t.Fatalf("want %v, but %v:", uint16(0), got)
+	}
+	if got := val.ValueOr(uint16(2)); got != uint16(2) {
+		t.Fatalf("want %v, but %v:", uint16(2), got)
+	}
+}
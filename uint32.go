@@ -0,0 +1,156 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+)
+
+// Uint32 represents a uint32 that may be null.
+type Uint32 struct {
+	Uint32 uint32
+	Valid  bool
+}
+
+// NewUint32 creates a new Uint32
+func NewUint32(u32 uint32, valid bool) Uint32 {
+	return Uint32{Uint32: u32, Valid: valid}
+}
+
+// Uint32From creates a new Uint32 that is always valid.
+func Uint32From(u uint32) Uint32 {
+	return NewUint32(u, true)
+}
+
+// Uint32FromPtr creates a new Uint32 that is null if u is nil.
+func Uint32FromPtr(u *uint32) Uint32 {
+	if u == nil {
+		return Uint32{}
+	}
+	return NewUint32(*u, true)
+}
+
+// Scan implements the Scanner interface.
+// Numbers of any integer type and floats with no fractional part are accepted,
+// and a number out of the range of Uint32 is reported as an *OverflowError.
+func (u *Uint32) Scan(value interface{}) error {
+	n := Value[uint32]{}
+	if err := n.scan("Uint32", value); err != nil {
+		return err
+	}
+	u.Uint32, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (u Uint32) Value() (driver.Value, error) {
+	return Value[uint32]{V: u.Uint32, Valid: u.Valid}.Value()
+}
+
+// MarshalJSON encode the value to JSON.
+func (u Uint32) MarshalJSON() ([]byte, error) {
+	return Value[uint32]{V: u.Uint32, Valid: u.Valid}.MarshalJSON()
+}
+
+// UnmarshalJSON decode data to the value.
+// A quoted value is accepted as well if LenientJSON is true.
+func (u *Uint32) UnmarshalJSON(data []byte) error {
+	n := Value[uint32]{}
+	if err := n.unmarshalJSON("Uint32", data); err != nil {
+		return err
+	}
+	u.Uint32, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (u Uint32) MarshalText() ([]byte, error) {
+	return Value[uint32]{V: u.Uint32, Valid: u.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (u *Uint32) UnmarshalText(text []byte) error {
+	n := Value[uint32]{}
+	if err := n.unmarshalText("Uint32", text); err != nil {
+		return err
+	}
+	u.Uint32, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (u Uint32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, u.Valid, u)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (u *Uint32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, u)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (u Uint32) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, u.Valid, u)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (u *Uint32) UnmarshalXMLAttr(attr xml.Attr) error {
+	return u.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (u Uint32) MarshalYAML() (interface{}, error) {
+	return Value[uint32]{V: u.Uint32, Valid: u.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (u *Uint32) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[uint32]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
+	}
+	u.Uint32, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (u Uint32) LogValue() slog.Value {
+	return Value[uint32]{V: u.Uint32, Valid: u.Valid}.LogValue()
+}
+
+// Format implements the fmt.Formatter interface.
+func (u Uint32) Format(state fmt.State, verb rune) {
+	format(state, verb, u, u.Valid, u.Uint32)
+}
+
+// String implements the fmt.Stringer interface.
+func (u Uint32) String() string {
+	return fmt.Sprint(u)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (u Uint32) Ptr() *uint32 {
+	return Value[uint32]{V: u.Uint32, Valid: u.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (u Uint32) ValueOrZero() uint32 {
+	return Value[uint32]{V: u.Uint32, Valid: u.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (u Uint32) ValueOr(v uint32) uint32 {
+	return Value[uint32]{V: u.Uint32, Valid: u.Valid}.ValueOr(v)
+}
+
+// IsNull returns true if Valid is false.
+func (u *Uint32) IsNull() bool {
+	return !u.Valid
+}
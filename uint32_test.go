@@ -0,0 +1,376 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestUint32ScanNull(t *testing.T) {
+	val := Uint32{}
+	if err := val.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint32(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint32ScanString(t *testing.T) {
+	val := Uint32{}
+	if err := val.Scan("1"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint32(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint32ScanByte(t *testing.T) {
+	val := Uint32{}
+	if err := val.Scan([]byte("1")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint32(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint32ScanInt(t *testing.T) {
+	val := Uint32{}
+	var i int = 1
+	if err := val.Scan(i); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint32(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint32ScanIntNegative(t *testing.T) {
+	val := Uint32{}
+	var i int = -1
+	err := val.Scan(i)
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
+	}
+}
+
+func TestUint32ScanInt64(t *testing.T) {
+	val := Uint32{}
+	var i64 int64 = 1
+	if err := val.Scan(i64); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint32(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint32ScanUint8(t *testing.T) {
+	val := Uint32{}
+	var u8 uint8 = 1
+	if err := val.Scan(u8); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint32(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint32ScanUint64(t *testing.T) {
+	val := Uint32{}
+	var u64 uint64 = 1
+	if err := val.Scan(u64); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint32(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint32ScanMaximumValueOver(t *testing.T) {
+	val := Uint32{}
+	var u64 uint64 = math.MaxUint32 + 1
+	err := val.Scan(u64)
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
+	}
+}
+
+func TestUint32ScanStringParseError(t *testing.T) {
+	val := Uint32{}
+	err := val.Scan("-1")
+	if err == nil {
+		t.Fatalf("no error is output")
+	}
+}
+
+func TestUint32ScanStringByteError(t *testing.T) {
+	val := Uint32{}
+	err := val.Scan([]byte("foo"))
+	if err == nil {
+		t.Fatalf("no error is output")
+	}
+}
+
+func TestUint32ScanTypeError(t *testing.T) {
+	val := Uint32{}
+	err := val.Scan(struct{}{})
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+}
+
+func TestUint32ValueInt(t *testing.T) {
+	val := NewUint32(1, true)
+	got, err := val.Value()
+	if got != int64(1) || err != nil {
+		t.Fatalf("want %v, but %v:", "1", got)
+	}
+}
+
+func TestUint32ValueZero(t *testing.T) {
+	val := NewUint32(0, true)
+	got, err := val.Value()
+	if got != int64(0) || err != nil {
+		t.Fatalf("want %v, but %v:", 0, got)
+	}
+}
+
+func TestUint32ValueNull(t *testing.T) {
+	val := NewUint32(0, false)
+	got, err := val.Value()
+	if got != nil || err != nil {
+		t.Fatalf("want %v, but %v:", 0, got)
+	}
+}
+
+func TestUint32MarshalJSONInt(t *testing.T) {
+	val := NewUint32(1, true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "1"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUint32MarshalJSONZero(t *testing.T) {
+	val := NewUint32(0, true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "0"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUint32MarshalJSONNull(t *testing.T) {
+	val := NewUint32(0, false)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "null"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUint32UnmarshalJSONInt(t *testing.T) {
+	var val Uint32
+	err := json.NewDecoder(strings.NewReader("1")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint32(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint32UnmarshalJSONZero(t *testing.T) {
+	var val Uint32
+	err := json.NewDecoder(strings.NewReader("0")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint32(0, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint32UnmarshalJSONNull(t *testing.T) {
+	var val Uint32
+	err := json.NewDecoder(strings.NewReader("null")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint32(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint32UnmarshalJSONError(t *testing.T) {
+	val := Uint32{}
+	err := val.UnmarshalJSON([]byte("-1"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestUint32MarshalText(t *testing.T) {
+	val := NewUint32(1, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `1`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestUint32MarshalTextNull(t *testing.T) {
+	val := NewUint32(1, false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestUint32UnmarshalText(t *testing.T) {
+	var val Uint32
+	if err := val.UnmarshalText([]byte(`1`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint32(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint32UnmarshalTextEmpty(t *testing.T) {
+	val := NewUint32(1, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint32(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint32UnmarshalTextError(t *testing.T) {
+	val := Uint32{}
+	err := val.UnmarshalText([]byte("-1"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestUint32IsNull(t *testing.T) {
+	val := NewUint32(0, true)
+	if val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+
+	val = NewUint32(0, false)
+	if !val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+}
+
+func TestUint32From(t *testing.T) {
+	val := Uint32From(uint32(1))
+	want := NewUint32(uint32(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint32FromPtr(t *testing.T) {
+	v := uint32(1)
+	val := Uint32FromPtr(&v)
+	want := NewUint32(uint32(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = Uint32FromPtr(nil)
+	want = Uint32{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint32Ptr(t *testing.T) {
+	val := NewUint32(uint32(1), true)
+	got := val.Ptr()
+	if got == nil || *got != uint32(1) {
+		t.Fatalf("want %v, but %v:", uint32(1), got)
+	}
+
+	val = NewUint32(uint32(1), false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestUint32ValueOr(t *testing.T) {
+	val := NewUint32(uint32(1), true)
+	if got := val.ValueOrZero(); got != uint32(1) {
+		t.Fatalf("want %v, but %v:", uint32(1), got)
+	}
+	if got := val.ValueOr(uint32(2)); got != uint32(1) {
+		t.Fatalf("want %v, but %v:", uint32(1), got)
+	}
+
+	val = NewUint32(uint32(1), false)
+	if got := val.ValueOrZero(); got != uint32(0) {
+		t.Fatalf("want %v, but %v:", uint32(0), got)
+	}
+	if got := val.ValueOr(uint32(2)); got != uint32(2) {
+		t.Fatalf("want %v, but %v:", uint32(2), got)
+	}
+}
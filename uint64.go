@@ -0,0 +1,158 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+)
+
+// Uint64 represents a uint64 that may be null.
+// Since driver.Value cannot hold a uint64, Value returns values greater than
+// math.MaxInt64 as a decimal string.
+type Uint64 struct {
+	Uint64 uint64
+	Valid  bool
+}
+
+// NewUint64 creates a new Uint64
+func NewUint64(u64 uint64, valid bool) Uint64 {
+	return Uint64{Uint64: u64, Valid: valid}
+}
+
+// Uint64From creates a new Uint64 that is always valid.
+func Uint64From(u uint64) Uint64 {
+	return NewUint64(u, true)
+}
+
+// Uint64FromPtr creates a new Uint64 that is null if u is nil.
+func Uint64FromPtr(u *uint64) Uint64 {
+	if u == nil {
+		return Uint64{}
+	}
+	return NewUint64(*u, true)
+}
+
+// Scan implements the Scanner interface.
+// Numbers of any integer type and floats with no fractional part are accepted,
+// and a number out of the range of Uint64 is reported as an *OverflowError.
+func (u *Uint64) Scan(value interface{}) error {
+	n := Value[uint64]{}
+	if err := n.scan("Uint64", value); err != nil {
+		return err
+	}
+	u.Uint64, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (u Uint64) Value() (driver.Value, error) {
+	return Value[uint64]{V: u.Uint64, Valid: u.Valid}.Value()
+}
+
+// MarshalJSON encode the value to JSON.
+func (u Uint64) MarshalJSON() ([]byte, error) {
+	return Value[uint64]{V: u.Uint64, Valid: u.Valid}.MarshalJSON()
+}
+
+// UnmarshalJSON decode data to the value.
+// A quoted value is accepted as well if LenientJSON is true.
+func (u *Uint64) UnmarshalJSON(data []byte) error {
+	n := Value[uint64]{}
+	if err := n.unmarshalJSON("Uint64", data); err != nil {
+		return err
+	}
+	u.Uint64, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (u Uint64) MarshalText() ([]byte, error) {
+	return Value[uint64]{V: u.Uint64, Valid: u.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (u *Uint64) UnmarshalText(text []byte) error {
+	n := Value[uint64]{}
+	if err := n.unmarshalText("Uint64", text); err != nil {
+		return err
+	}
+	u.Uint64, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (u Uint64) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, u.Valid, u)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (u *Uint64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, u)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (u Uint64) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, u.Valid, u)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (u *Uint64) UnmarshalXMLAttr(attr xml.Attr) error {
+	return u.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (u Uint64) MarshalYAML() (interface{}, error) {
+	return Value[uint64]{V: u.Uint64, Valid: u.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (u *Uint64) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[uint64]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
+	}
+	u.Uint64, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (u Uint64) LogValue() slog.Value {
+	return Value[uint64]{V: u.Uint64, Valid: u.Valid}.LogValue()
+}
+
+// Format implements the fmt.Formatter interface.
+func (u Uint64) Format(state fmt.State, verb rune) {
+	format(state, verb, u, u.Valid, u.Uint64)
+}
+
+// String implements the fmt.Stringer interface.
+func (u Uint64) String() string {
+	return fmt.Sprint(u)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (u Uint64) Ptr() *uint64 {
+	return Value[uint64]{V: u.Uint64, Valid: u.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (u Uint64) ValueOrZero() uint64 {
+	return Value[uint64]{V: u.Uint64, Valid: u.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (u Uint64) ValueOr(v uint64) uint64 {
+	return Value[uint64]{V: u.Uint64, Valid: u.Valid}.ValueOr(v)
+}
+
+// IsNull returns true if Valid is false.
+func (u *Uint64) IsNull() bool {
+	return !u.Valid
+}
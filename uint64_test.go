@@ -0,0 +1,400 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestUint64ScanNull(t *testing.T) {
+	val := Uint64{}
+	if err := val.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint64(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint64ScanString(t *testing.T) {
+	val := Uint64{}
+	if err := val.Scan("1"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint64(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint64ScanByte(t *testing.T) {
+	val := Uint64{}
+	if err := val.Scan([]byte("1")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint64(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint64ScanInt(t *testing.T) {
+	val := Uint64{}
+	var i int = 1
+	if err := val.Scan(i); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint64(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint64ScanIntNegative(t *testing.T) {
+	val := Uint64{}
+	var i int = -1
+	err := val.Scan(i)
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
+	}
+}
+
+func TestUint64ScanInt64(t *testing.T) {
+	val := Uint64{}
+	var i64 int64 = 1
+	if err := val.Scan(i64); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint64(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint64ScanUint8(t *testing.T) {
+	val := Uint64{}
+	var u8 uint8 = 1
+	if err := val.Scan(u8); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint64(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint64ScanUint64(t *testing.T) {
+	val := Uint64{}
+	var u64 uint64 = 1
+	if err := val.Scan(u64); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint64(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint64ScanMaxUint64(t *testing.T) {
+	val := Uint64{}
+	var u64 uint64 = 18446744073709551615
+	if err := val.Scan(u64); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint64(18446744073709551615, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint64ScanStringMaxUint64(t *testing.T) {
+	val := Uint64{}
+	if err := val.Scan("18446744073709551615"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint64(18446744073709551615, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint64ScanStringParseError(t *testing.T) {
+	val := Uint64{}
+	err := val.Scan("-1")
+	if err == nil {
+		t.Fatalf("no error is output")
+	}
+}
+
+func TestUint64ScanStringByteError(t *testing.T) {
+	val := Uint64{}
+	err := val.Scan([]byte("foo"))
+	if err == nil {
+		t.Fatalf("no error is output")
+	}
+}
+
+func TestUint64ScanTypeError(t *testing.T) {
+	val := Uint64{}
+	err := val.Scan(struct{}{})
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+}
+
+func TestUint64ValueInt(t *testing.T) {
+	val := NewUint64(1, true)
+	got, err := val.Value()
+	if got != int64(1) || err != nil {
+		t.Fatalf("want %v, but %v:", "1", got)
+	}
+}
+
+func TestUint64ValueZero(t *testing.T) {
+	val := NewUint64(0, true)
+	got, err := val.Value()
+	if got != int64(0) || err != nil {
+		t.Fatalf("want %v, but %v:", 0, got)
+	}
+}
+
+func TestUint64ValueOverMaxInt64(t *testing.T) {
+	val := NewUint64(math.MaxUint64, true)
+	got, err := val.Value()
+	if got != "18446744073709551615" || err != nil {
+		t.Fatalf("want %v, but %v:", "18446744073709551615", got)
+	}
+}
+
+func TestUint64ValueNull(t *testing.T) {
+	val := NewUint64(0, false)
+	got, err := val.Value()
+	if got != nil || err != nil {
+		t.Fatalf("want %v, but %v:", 0, got)
+	}
+}
+
+func TestUint64MarshalJSONInt(t *testing.T) {
+	val := NewUint64(1, true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "1"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUint64MarshalJSONZero(t *testing.T) {
+	val := NewUint64(0, true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "0"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUint64MarshalJSONNull(t *testing.T) {
+	val := NewUint64(0, false)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "null"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUint64UnmarshalJSONInt(t *testing.T) {
+	var val Uint64
+	err := json.NewDecoder(strings.NewReader("1")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint64(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint64UnmarshalJSONZero(t *testing.T) {
+	var val Uint64
+	err := json.NewDecoder(strings.NewReader("0")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint64(0, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint64UnmarshalJSONNull(t *testing.T) {
+	var val Uint64
+	err := json.NewDecoder(strings.NewReader("null")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint64(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint64UnmarshalJSONError(t *testing.T) {
+	val := Uint64{}
+	err := val.UnmarshalJSON([]byte("-1"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestUint64MarshalText(t *testing.T) {
+	val := NewUint64(18446744073709551615, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `18446744073709551615`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestUint64MarshalTextNull(t *testing.T) {
+	val := NewUint64(1, false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestUint64UnmarshalText(t *testing.T) {
+	var val Uint64
+	if err := val.UnmarshalText([]byte(`18446744073709551615`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint64(18446744073709551615, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint64UnmarshalTextEmpty(t *testing.T) {
+	val := NewUint64(1, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint64(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint64UnmarshalTextError(t *testing.T) {
+	val := Uint64{}
+	err := val.UnmarshalText([]byte("-1"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestUint64IsNull(t *testing.T) {
+	val := NewUint64(0, true)
+	if val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+
+	val = NewUint64(0, false)
+	if !val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+}
+
+func TestUint64From(t *testing.T) {
+	val := Uint64From(uint64(1))
+	want := NewUint64(uint64(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint64FromPtr(t *testing.T) {
+	v := uint64(1)
+	val := Uint64FromPtr(&v)
+	want := NewUint64(uint64(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = Uint64FromPtr(nil)
+	want = Uint64{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint64Ptr(t *testing.T) {
+	val := NewUint64(uint64(1), true)
+	got := val.Ptr()
+	if got == nil || *got != uint64(1) {
+		t.Fatalf("want %v, but %v:", uint64(1), got)
+	}
+
+	val = NewUint64(uint64(1), false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestUint64ValueOr(t *testing.T) {
+	val := NewUint64(uint64(1), true)
+	if got := val.ValueOrZero(); got != uint64(1) {
+		t.Fatalf("want %v, but %v:", uint64(1), got)
+	}
+	if got := val.ValueOr(uint64(2)); got != uint64(1) {
+		t.Fatalf("want %v, but %v:", uint64(1), got)
+	}
+
+	val = NewUint64(uint64(1), false)
+	if got := val.ValueOrZero(); got != uint64(0) {
+		t.Fatalf("want %v, but %v:", uint64(0), got)
+	}
+	if got := val.ValueOr(uint64(2)); got != uint64(2) {
+		t.Fatalf("want %v, but %v:", uint64(2), got)
+	}
+}
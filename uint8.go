@@ -0,0 +1,156 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+)
+
+// Uint8 represents a uint8 that may be null.
+type Uint8 struct {
+	Uint8 uint8
+	Valid bool
+}
+
+// NewUint8 creates a new Uint8
+func NewUint8(u8 uint8, valid bool) Uint8 {
+	return Uint8{Uint8: u8, Valid: valid}
+}
+
+// Uint8From creates a new Uint8 that is always valid.
+func Uint8From(u uint8) Uint8 {
+	return NewUint8(u, true)
+}
+
+// Uint8FromPtr creates a new Uint8 that is null if u is nil.
+func Uint8FromPtr(u *uint8) Uint8 {
+	if u == nil {
+		return Uint8{}
+	}
+	return NewUint8(*u, true)
+}
+
+// Scan implements the Scanner interface.
+// Numbers of any integer type and floats with no fractional part are accepted,
+// and a number out of the range of Uint8 is reported as an *OverflowError.
+func (u *Uint8) Scan(value interface{}) error {
+	n := Value[uint8]{}
+	if err := n.scan("Uint8", value); err != nil {
+		return err
+	}
+	u.Uint8, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (u Uint8) Value() (driver.Value, error) {
+	return Value[uint8]{V: u.Uint8, Valid: u.Valid}.Value()
+}
+
+// MarshalJSON encode the value to JSON.
+func (u Uint8) MarshalJSON() ([]byte, error) {
+	return Value[uint8]{V: u.Uint8, Valid: u.Valid}.MarshalJSON()
+}
+
+// UnmarshalJSON decode data to the value.
+// A quoted value is accepted as well if LenientJSON is true.
+func (u *Uint8) UnmarshalJSON(data []byte) error {
+	n := Value[uint8]{}
+	if err := n.unmarshalJSON("Uint8", data); err != nil {
+		return err
+	}
+	u.Uint8, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (u Uint8) MarshalText() ([]byte, error) {
+	return Value[uint8]{V: u.Uint8, Valid: u.Valid}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (u *Uint8) UnmarshalText(text []byte) error {
+	n := Value[uint8]{}
+	if err := n.unmarshalText("Uint8", text); err != nil {
+		return err
+	}
+	u.Uint8, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (u Uint8) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, u.Valid, u)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (u *Uint8) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, u)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (u Uint8) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, u.Valid, u)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (u *Uint8) UnmarshalXMLAttr(attr xml.Attr) error {
+	return u.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (u Uint8) MarshalYAML() (interface{}, error) {
+	return Value[uint8]{V: u.Uint8, Valid: u.Valid}.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (u *Uint8) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	n := Value[uint8]{}
+	if err := n.UnmarshalYAML(unmarshal); err != nil {
+		return err
+	}
+	u.Uint8, u.Valid = n.V, n.Valid
+	return nil
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (u Uint8) LogValue() slog.Value {
+	return Value[uint8]{V: u.Uint8, Valid: u.Valid}.LogValue()
+}
+
+// Format implements the fmt.Formatter interface.
+func (u Uint8) Format(state fmt.State, verb rune) {
+	format(state, verb, u, u.Valid, u.Uint8)
+}
+
+// String implements the fmt.Stringer interface.
+func (u Uint8) String() string {
+	return fmt.Sprint(u)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (u Uint8) Ptr() *uint8 {
+	return Value[uint8]{V: u.Uint8, Valid: u.Valid}.Ptr()
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (u Uint8) ValueOrZero() uint8 {
+	return Value[uint8]{V: u.Uint8, Valid: u.Valid}.ValueOrZero()
+}
+
+// ValueOr returns the value, or v if it is null.
+func (u Uint8) ValueOr(v uint8) uint8 {
+	return Value[uint8]{V: u.Uint8, Valid: u.Valid}.ValueOr(v)
+}
+
+// IsNull returns true if Valid is false.
+func (u *Uint8) IsNull() bool {
+	return !u.Valid
+}
@@ -0,0 +1,376 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestUint8ScanNull(t *testing.T) {
+	val := Uint8{}
+	if err := val.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint8(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint8ScanString(t *testing.T) {
+	val := Uint8{}
+	if err := val.Scan("1"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint8(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint8ScanByte(t *testing.T) {
+	val := Uint8{}
+	if err := val.Scan([]byte("1")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint8(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint8ScanInt(t *testing.T) {
+	val := Uint8{}
+	var i int = 1
+	if err := val.Scan(i); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint8(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint8ScanIntNegative(t *testing.T) {
+	val := Uint8{}
+	var i int = -1
+	err := val.Scan(i)
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
+	}
+}
+
+func TestUint8ScanInt64(t *testing.T) {
+	val := Uint8{}
+	var i64 int64 = 1
+	if err := val.Scan(i64); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint8(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint8ScanUint8(t *testing.T) {
+	val := Uint8{}
+	var u8 uint8 = 1
+	if err := val.Scan(u8); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint8(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint8ScanUint64(t *testing.T) {
+	val := Uint8{}
+	var u64 uint64 = 1
+	if err := val.Scan(u64); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint8(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint8ScanMaximumValueOver(t *testing.T) {
+	val := Uint8{}
+	var u64 uint64 = math.MaxUint8 + 1
+	err := val.Scan(u64)
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
+	}
+}
+
+func TestUint8ScanStringParseError(t *testing.T) {
+	val := Uint8{}
+	err := val.Scan("-1")
+	if err == nil {
+		t.Fatalf("no error is output")
+	}
+}
+
+func TestUint8ScanStringByteError(t *testing.T) {
+	val := Uint8{}
+	err := val.Scan([]byte("foo"))
+	if err == nil {
+		t.Fatalf("no error is output")
+	}
+}
+
+func TestUint8ScanTypeError(t *testing.T) {
+	val := Uint8{}
+	err := val.Scan(struct{}{})
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+}
+
+func TestUint8ValueInt(t *testing.T) {
+	val := NewUint8(1, true)
+	got, err := val.Value()
+	if got != int64(1) || err != nil {
+		t.Fatalf("want %v, but %v:", "1", got)
+	}
+}
+
+func TestUint8ValueZero(t *testing.T) {
+	val := NewUint8(0, true)
+	got, err := val.Value()
+	if got != int64(0) || err != nil {
+		t.Fatalf("want %v, but %v:", 0, got)
+	}
+}
+
+func TestUint8ValueNull(t *testing.T) {
+	val := NewUint8(0, false)
+	got, err := val.Value()
+	if got != nil || err != nil {
+		t.Fatalf("want %v, but %v:", 0, got)
+	}
+}
+
+func TestUint8MarshalJSONInt(t *testing.T) {
+	val := NewUint8(1, true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "1"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUint8MarshalJSONZero(t *testing.T) {
+	val := NewUint8(0, true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "0"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUint8MarshalJSONNull(t *testing.T) {
+	val := NewUint8(0, false)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "null"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUint8UnmarshalJSONInt(t *testing.T) {
+	var val Uint8
+	err := json.NewDecoder(strings.NewReader("1")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint8(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint8UnmarshalJSONZero(t *testing.T) {
+	var val Uint8
+	err := json.NewDecoder(strings.NewReader("0")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint8(0, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint8UnmarshalJSONNull(t *testing.T) {
+	var val Uint8
+	err := json.NewDecoder(strings.NewReader("null")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint8(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint8UnmarshalJSONError(t *testing.T) {
+	val := Uint8{}
+	err := val.UnmarshalJSON([]byte("-1"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestUint8MarshalText(t *testing.T) {
+	val := NewUint8(1, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `1`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestUint8MarshalTextNull(t *testing.T) {
+	val := NewUint8(1, false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestUint8UnmarshalText(t *testing.T) {
+	var val Uint8
+	if err := val.UnmarshalText([]byte(`1`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint8(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint8UnmarshalTextEmpty(t *testing.T) {
+	val := NewUint8(1, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint8(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint8UnmarshalTextError(t *testing.T) {
+	val := Uint8{}
+	err := val.UnmarshalText([]byte("256"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestUint8IsNull(t *testing.T) {
+	val := NewUint8(0, true)
+	if val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+
+	val = NewUint8(0, false)
+	if !val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+}
+
+func TestUint8From(t *testing.T) {
+	val := Uint8From(uint8(1))
+	want := NewUint8(uint8(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint8FromPtr(t *testing.T) {
+	v := uint8(1)
+	val := Uint8FromPtr(&v)
+	want := NewUint8(uint8(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = Uint8FromPtr(nil)
+	want = Uint8{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUint8Ptr(t *testing.T) {
+	val := NewUint8(uint8(1), true)
+	got := val.Ptr()
+	if got == nil || *got != uint8(1) {
+		t.Fatalf("want %v, but %v:", uint8(1), got)
+	}
+
+	val = NewUint8(uint8(1), false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestUint8ValueOr(t *testing.T) {
+	val := NewUint8(uint8(1), true)
+	if got := val.ValueOrZero(); got != uint8(1) {
+		t.Fatalf("want %v, but %v:", uint8(1), got)
+	}
+	if got := val.ValueOr(uint8(2)); got != uint8(1) {
+		t.Fatalf("want %v, but %v:", uint8(1), got)
+	}
+
+	val = NewUint8(uint8(1), false)
+	if got := val.ValueOrZero(); got != uint8(0) {
+		t.Fatalf("want %v, but %v:", uint8(0), got)
+	}
+	if got := val.ValueOr(uint8(2)); got != uint8(2) {
+		t.Fatalf("want %v, but %v:", uint8(2), got)
+	}
+}
@@ -0,0 +1,366 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestUintScanNull(t *testing.T) {
+	val := Uint{}
+	if err := val.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUintScanString(t *testing.T) {
+	val := Uint{}
+	if err := val.Scan("1"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUintScanByte(t *testing.T) {
+	val := Uint{}
+	if err := val.Scan([]byte("1")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUintScanInt(t *testing.T) {
+	val := Uint{}
+	var i int = 1
+	if err := val.Scan(i); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUintScanIntNegative(t *testing.T) {
+	val := Uint{}
+	var i int = -1
+	err := val.Scan(i)
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
+	}
+}
+
+func TestUintScanInt64(t *testing.T) {
+	val := Uint{}
+	var i64 int64 = 1
+	if err := val.Scan(i64); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUintScanUint8(t *testing.T) {
+	val := Uint{}
+	var u8 uint8 = 1
+	if err := val.Scan(u8); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUintScanUint64(t *testing.T) {
+	val := Uint{}
+	var u64 uint64 = 1
+	if err := val.Scan(u64); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUintScanStringParseError(t *testing.T) {
+	val := Uint{}
+	err := val.Scan("-1")
+	if err == nil {
+		t.Fatalf("no error is output")
+	}
+}
+
+func TestUintScanStringByteError(t *testing.T) {
+	val := Uint{}
+	err := val.Scan([]byte("foo"))
+	if err == nil {
+		t.Fatalf("no error is output")
+	}
+}
+
+func TestUintScanTypeError(t *testing.T) {
+	val := Uint{}
+	err := val.Scan(struct{}{})
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+}
+
+func TestUintValueInt(t *testing.T) {
+	val := NewUint(1, true)
+	got, err := val.Value()
+	if got != int64(1) || err != nil {
+		t.Fatalf("want %v, but %v:", "1", got)
+	}
+}
+
+func TestUintValueZero(t *testing.T) {
+	val := NewUint(0, true)
+	got, err := val.Value()
+	if got != int64(0) || err != nil {
+		t.Fatalf("want %v, but %v:", 0, got)
+	}
+}
+
+func TestUintValueNull(t *testing.T) {
+	val := NewUint(0, false)
+	got, err := val.Value()
+	if got != nil || err != nil {
+		t.Fatalf("want %v, but %v:", 0, got)
+	}
+}
+
+func TestUintMarshalJSONInt(t *testing.T) {
+	val := NewUint(1, true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "1"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUintMarshalJSONZero(t *testing.T) {
+	val := NewUint(0, true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "0"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUintMarshalJSONNull(t *testing.T) {
+	val := NewUint(0, false)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "null"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUintUnmarshalJSONInt(t *testing.T) {
+	var val Uint
+	err := json.NewDecoder(strings.NewReader("1")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUintUnmarshalJSONZero(t *testing.T) {
+	var val Uint
+	err := json.NewDecoder(strings.NewReader("0")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint(0, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUintUnmarshalJSONNull(t *testing.T) {
+	var val Uint
+	err := json.NewDecoder(strings.NewReader("null")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUintUnmarshalJSONError(t *testing.T) {
+	val := Uint{}
+	err := val.UnmarshalJSON([]byte("-1"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestUintMarshalText(t *testing.T) {
+	val := NewUint(1, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `1`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestUintMarshalTextNull(t *testing.T) {
+	val := NewUint(1, false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestUintUnmarshalText(t *testing.T) {
+	var val Uint
+	if err := val.UnmarshalText([]byte(`1`)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUintUnmarshalTextEmpty(t *testing.T) {
+	val := NewUint(1, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUint(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUintUnmarshalTextError(t *testing.T) {
+	val := Uint{}
+	err := val.UnmarshalText([]byte("-1"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestUintIsNull(t *testing.T) {
+	val := NewUint(0, true)
+	if val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+
+	val = NewUint(0, false)
+	if !val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+}
+
+func TestUintFrom(t *testing.T) {
+	val := UintFrom(uint(1))
+	want := NewUint(uint(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUintFromPtr(t *testing.T) {
+	v := uint(1)
+	val := UintFromPtr(&v)
+	want := NewUint(uint(1), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = UintFromPtr(nil)
+	want = Uint{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUintPtr(t *testing.T) {
+	val := NewUint(uint(1), true)
+	got := val.Ptr()
+	if got == nil || *got != uint(1) {
+		t.Fatalf("want %v, but %v:", uint(1), got)
+	}
+
+	val = NewUint(uint(1), false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestUintValueOr(t *testing.T) {
+	val := NewUint(uint(1), true)
+	if got := val.ValueOrZero(); got != uint(1) {
+		t.Fatalf("want %v, but %v:", uint(1), got)
+	}
+	if got := val.ValueOr(uint(2)); got != uint(1) {
+		t.Fatalf("want %v, but %v:", uint(1), got)
+	}
+
+	val = NewUint(uint(1), false)
+	if got := val.ValueOrZero(); got != uint(0) {
+		t.Fatalf("want %v, but %v:", uint(0), got)
+	}
+	if got := val.ValueOr(uint(2)); got != uint(2) {
+		t.Fatalf("want %v, but %v:", uint(2), got)
+	}
+}
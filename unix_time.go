@@ -0,0 +1,178 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Precision provides the unit a Unix counts in, e.g.
+//
+//	type Nanos struct{}
+//
+//	func (Nanos) Unit() time.Duration { return time.Nanosecond }
+//
+// The unit must divide a second.
+type Precision interface {
+	Unit() time.Duration
+}
+
+// Seconds is the Precision of Unix seconds.
+type Seconds struct{}
+
+// Unit returns time.Second.
+func (Seconds) Unit() time.Duration { return time.Second }
+
+// Millis is the Precision of Unix milliseconds.
+type Millis struct{}
+
+// Unit returns time.Millisecond.
+func (Millis) Unit() time.Duration { return time.Millisecond }
+
+// Micros is the Precision of Unix microseconds.
+type Micros struct{}
+
+// Unit returns time.Microsecond.
+func (Micros) Unit() time.Duration { return time.Microsecond }
+
+// Unix represents a time that may be null and is encoded in JSON as an integer number of
+// units of P since the Unix epoch, so that fields with different precisions can coexist.
+// Scan reads an integer in the same unit. It behaves like Time in every other respect.
+type Unix[P Precision] struct {
+	Time
+}
+
+// UnixTime represents a time that may be null and is encoded in JSON as Unix seconds.
+type UnixTime = Unix[Seconds]
+
+// UnixMilliTime represents a time that may be null and is encoded in JSON as Unix milliseconds.
+type UnixMilliTime = Unix[Millis]
+
+// UnixMicroTime represents a time that may be null and is encoded in JSON as Unix microseconds.
+type UnixMicroTime = Unix[Micros]
+
+// NewUnix creates a new Unix
+func NewUnix[P Precision](t time.Time, valid bool) Unix[P] {
+	return Unix[P]{Time: NewTime(t, valid)}
+}
+
+// UnixFrom creates a new Unix that is always valid.
+func UnixFrom[P Precision](t time.Time) Unix[P] {
+	return NewUnix[P](t, true)
+}
+
+// UnixFromPtr creates a new Unix that is null if t is nil.
+func UnixFromPtr[P Precision](t *time.Time) Unix[P] {
+	return Unix[P]{Time: TimeFromPtr(t)}
+}
+
+// NewUnixTime creates a new UnixTime
+func NewUnixTime(t time.Time, valid bool) UnixTime {
+	return NewUnix[Seconds](t, valid)
+}
+
+// UnixTimeFrom creates a new UnixTime that is always valid.
+func UnixTimeFrom(t time.Time) UnixTime {
+	return UnixFrom[Seconds](t)
+}
+
+// UnixTimeFromPtr creates a new UnixTime that is null if t is nil.
+func UnixTimeFromPtr(t *time.Time) UnixTime {
+	return UnixFromPtr[Seconds](t)
+}
+
+// NewUnixMilliTime creates a new UnixMilliTime
+func NewUnixMilliTime(t time.Time, valid bool) UnixMilliTime {
+	return NewUnix[Millis](t, valid)
+}
+
+// UnixMilliTimeFrom creates a new UnixMilliTime that is always valid.
+func UnixMilliTimeFrom(t time.Time) UnixMilliTime {
+	return UnixFrom[Millis](t)
+}
+
+// UnixMilliTimeFromPtr creates a new UnixMilliTime that is null if t is nil.
+func UnixMilliTimeFromPtr(t *time.Time) UnixMilliTime {
+	return UnixFromPtr[Millis](t)
+}
+
+// NewUnixMicroTime creates a new UnixMicroTime
+func NewUnixMicroTime(t time.Time, valid bool) UnixMicroTime {
+	return NewUnix[Micros](t, valid)
+}
+
+// UnixMicroTimeFrom creates a new UnixMicroTime that is always valid.
+func UnixMicroTimeFrom(t time.Time) UnixMicroTime {
+	return UnixFrom[Micros](t)
+}
+
+// UnixMicroTimeFromPtr creates a new UnixMicroTime that is null if t is nil.
+func UnixMicroTimeFromPtr(t *time.Time) UnixMicroTime {
+	return UnixFromPtr[Micros](t)
+}
+
+// Scan implements the Scanner interface.
+// An integer is read as a number of units of P since the Unix epoch, in UTC.
+// Any other value is read as Time.Scan reads it.
+func (t *Unix[P]) Scan(value interface{}) error {
+	switch value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		n, err := scanInt(value, "Unix", math.MinInt64, math.MaxInt64)
+		if err != nil {
+			return newScanError("Unix", value, err)
+		}
+		t.Time.Time, t.Valid = fromUnix[P](n), true
+		return nil
+	default:
+		return t.Time.Scan(value)
+	}
+}
+
+// MarshalJSON encode the value to JSON.
+func (t Unix[P]) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	return marshalJSONInt(toUnix[P](t.Time.Time)), nil
+}
+
+// UnmarshalJSON decode data to the value.
+func (t *Unix[P]) UnmarshalJSON(data []byte) error {
+	var n *int64
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	if n == nil {
+		t.Time.Time, t.Valid = time.Time{}, false
+		return nil
+	}
+	t.Time.Time, t.Valid = fromUnix[P](*n), true
+	return nil
+}
+
+// Format implements the fmt.Formatter interface.
+func (t Unix[P]) Format(state fmt.State, verb rune) {
+	format(state, verb, t, t.Valid, t.Time.Time)
+}
+
+// String implements the fmt.Stringer interface.
+func (t Unix[P]) String() string {
+	return fmt.Sprint(t)
+}
+
+// toUnix returns t as a number of units of P since the Unix epoch, rounded down
+// like time.Time.UnixMilli.
+func toUnix[P Precision](t time.Time) int64 {
+	var p P
+	unit := int64(p.Unit())
+	return t.Unix()*(int64(time.Second)/unit) + int64(t.Nanosecond())/unit
+}
+
+// fromUnix returns the time in UTC n units of P after the Unix epoch.
+func fromUnix[P Precision](n int64) time.Time {
+	var p P
+	unit := int64(p.Unit())
+	perSecond := int64(time.Second) / unit
+	return time.Unix(n/perSecond, n%perSecond*unit).UTC()
+}
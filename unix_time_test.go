@@ -0,0 +1,213 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUnixTimeMarshalJSON(t *testing.T) {
+	val := struct {
+		Sec   UnixTime      `json:"sec"`
+		Milli UnixMilliTime `json:"milli"`
+		Micro UnixMicroTime `json:"micro"`
+	}{
+		Sec:   NewUnixTime(testTime, true),
+		Milli: NewUnixMilliTime(testTime.Add(123456*time.Microsecond), true),
+		Micro: NewUnixMicroTime(testTime.Add(123456*time.Microsecond), true),
+	}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"sec":1672531199,"milli":1672531199123,"micro":1672531199123456}`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUnixTimeMarshalJSONNull(t *testing.T) {
+	val := struct {
+		Sec   UnixTime      `json:"sec"`
+		Milli UnixMilliTime `json:"milli"`
+		Micro UnixMicroTime `json:"micro"`
+	}{}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"sec":null,"milli":null,"micro":null}`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUnixTimeUnmarshalJSON(t *testing.T) {
+	var val struct {
+		Sec   UnixTime      `json:"sec"`
+		Milli UnixMilliTime `json:"milli"`
+		Micro UnixMicroTime `json:"micro"`
+	}
+	data := `{"sec":1672531199,"milli":1672531199123,"micro":1672531199123456}`
+	if err := json.NewDecoder(strings.NewReader(data)).Decode(&val); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := NewUnixTime(testTime, true); val.Sec != want {
+		t.Fatalf("want %v, but %v:", want, val.Sec)
+	}
+	if want := NewUnixMilliTime(testTime.Add(123*time.Millisecond), true); val.Milli != want {
+		t.Fatalf("want %v, but %v:", want, val.Milli)
+	}
+	if want := NewUnixMicroTime(testTime.Add(123456*time.Microsecond), true); val.Micro != want {
+		t.Fatalf("want %v, but %v:", want, val.Micro)
+	}
+}
+
+func TestUnixTimeUnmarshalJSONNull(t *testing.T) {
+	val := NewUnixMilliTime(testTime, true)
+	err := json.NewDecoder(strings.NewReader("null")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUnixMilliTime(time.Time{}, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUnixTimeUnmarshalJSONError(t *testing.T) {
+	for _, data := range []string{`"2022-12-31T23:59:59Z"`, "1.5", "true"} {
+		val := UnixTime{}
+		if err := val.UnmarshalJSON([]byte(data)); err == nil {
+			t.Fatalf("no error message is output: %v", data)
+		}
+	}
+}
+
+func TestUnixTimeScan(t *testing.T) {
+	val := UnixTime{}
+	if err := val.Scan(testTime); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUnixTime(testTime, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUnixTimeScanInt(t *testing.T) {
+	sec := UnixTime{}
+	if err := sec.Scan(int64(1672531199)); err != nil {
+		t.Fatal(err)
+	}
+	if want := NewUnixTime(testTime, true); sec != want {
+		t.Fatalf("want %v, but %v:", want, sec)
+	}
+
+	milli := UnixMilliTime{}
+	if err := milli.Scan(int64(1672531199123)); err != nil {
+		t.Fatal(err)
+	}
+	if want := NewUnixMilliTime(testTime.Add(123*time.Millisecond), true); milli != want {
+		t.Fatalf("want %v, but %v:", want, milli)
+	}
+
+	micro := UnixMicroTime{}
+	if err := micro.Scan(int32(-1)); err != nil {
+		t.Fatal(err)
+	}
+	if want := NewUnixMicroTime(time.Unix(0, -1000).UTC(), true); micro != want {
+		t.Fatalf("want %v, but %v:", want, micro)
+	}
+
+	if err := micro.Scan(uint64(math.MaxUint64)); !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
+	}
+	if err := micro.Scan(nil); err != nil || micro.Valid {
+		t.Fatalf("want %v, but %v:", UnixMicroTime{}, micro)
+	}
+}
+
+type testNanos struct{}
+
+func (testNanos) Unit() time.Duration { return time.Nanosecond }
+
+func TestUnixPrecision(t *testing.T) {
+	want := testTime.Add(-time.Nanosecond)
+	val := NewUnix[testNanos](want, true)
+	data, err := json.Marshal(val)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "1672531198999999999" {
+		t.Fatalf("want %v, but %v:", "1672531198999999999", string(data))
+	}
+
+	var got Unix[testNanos]
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != val {
+		t.Fatalf("want %v, but %v:", val, got)
+	}
+
+	before := time.Unix(-1, 999999999).UTC()
+	if got := toUnix[Millis](before); got != before.UnixMilli() {
+		t.Fatalf("want %v, but %v:", before.UnixMilli(), got)
+	}
+	if got := fromUnix[Millis](before.UnixMilli()); !got.Equal(time.UnixMilli(before.UnixMilli())) {
+		t.Fatalf("want %v, but %v:", time.UnixMilli(before.UnixMilli()), got)
+	}
+}
+
+func TestUnixTimeFromPtr(t *testing.T) {
+	v := testTime
+	if val := UnixTimeFromPtr(&v); val != UnixTimeFrom(testTime) || !val.Valid {
+		t.Fatalf("want %v, but %v:", UnixTimeFrom(testTime), val)
+	}
+	if val := UnixMilliTimeFromPtr(&v); val != UnixMilliTimeFrom(testTime) || !val.Valid {
+		t.Fatalf("want %v, but %v:", UnixMilliTimeFrom(testTime), val)
+	}
+	if val := UnixMicroTimeFromPtr(&v); val != UnixMicroTimeFrom(testTime) || !val.Valid {
+		t.Fatalf("want %v, but %v:", UnixMicroTimeFrom(testTime), val)
+	}
+	if val := UnixTimeFromPtr(nil); val != (UnixTime{}) {
+		t.Fatalf("want %v, but %v:", UnixTime{}, val)
+	}
+}
+
+func TestUnixTimeFormat(t *testing.T) {
+	tests := []struct {
+		val  interface{}
+		want string
+	}{
+		{NewUnixTime(testTime, true), fmt.Sprintf("%T{Time:%#v}", UnixTime{}, NewTime(testTime, true))},
+		{NewUnixMilliTime(testTime, true), fmt.Sprintf("%T{Time:%#v}", UnixMilliTime{}, NewTime(testTime, true))},
+		{NewUnixMicroTime(testTime, false), fmt.Sprintf("%T{Time:%#v}", UnixMicroTime{}, NewTime(testTime, false))},
+	}
+	for _, tt := range tests {
+		got := fmt.Sprintf("%#v", tt.val)
+		if got != tt.want {
+			t.Fatalf("want %v, but %v:", tt.want, got)
+		}
+	}
+
+	if got := fmt.Sprint(NewUnixTime(testTime, true)); got != fmt.Sprint(testTime) {
+		t.Fatalf("want %v, but %v:", testTime, got)
+	}
+	if got := NewUnixMicroTime(testTime, false).String(); got != "<null>" {
+		t.Fatalf("want %v, but %v:", "<null>", got)
+	}
+}
@@ -0,0 +1,266 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// UUID represents a UUID that may be null.
+type UUID struct {
+	UUID  [16]byte
+	Valid bool
+}
+
+// NewUUID creates a new UUID
+func NewUUID(u [16]byte, valid bool) UUID {
+	return UUID{UUID: u, Valid: valid}
+}
+
+// UUIDFrom creates a new UUID that is always valid.
+func UUIDFrom(u [16]byte) UUID {
+	return NewUUID(u, true)
+}
+
+// UUIDFromPtr creates a new UUID that is null if u is nil.
+func UUIDFromPtr(u *[16]byte) UUID {
+	if u == nil {
+		return UUID{}
+	}
+	return NewUUID(*u, true)
+}
+
+// ParseUUID parses a UUID in the canonical form "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+// the braced form "{...}", the URN form "urn:uuid:..." or as 32 hex digits, in any case.
+func ParseUUID(s string) (UUID, error) {
+	u, err := parseUUID(s)
+	if err != nil {
+		return UUID{}, err
+	}
+	return UUID{UUID: u, Valid: true}, nil
+}
+
+// Scan implements the Scanner interface.
+// A []byte of length 16 is read as the binary form, anything else as text.
+func (u *UUID) Scan(value interface{}) error {
+	if value == nil {
+		u.UUID, u.Valid = [16]byte{}, false
+		return nil
+	}
+
+	switch data := value.(type) {
+	case string:
+		uuid, err := parseUUID(data)
+		if err != nil {
+			return newScanError("UUID", value, err)
+		}
+		u.UUID, u.Valid = uuid, true
+		return nil
+	case []byte:
+		if len(data) == 16 {
+			u.UUID, u.Valid = [16]byte(data), true
+			return nil
+		}
+		uuid, err := parseUUID(string(data))
+		if err != nil {
+			return newScanError("UUID", value, err)
+		}
+		u.UUID, u.Valid = uuid, true
+		return nil
+	default:
+		return newScanError("UUID", value, ErrUnsupportedType)
+	}
+}
+
+// Value implements the driver Valuer interface.
+// The value is the canonical text form. Use UUIDBinary for a column that holds the 16 bytes.
+func (u UUID) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return formatUUID(u.UUID), nil
+}
+
+// MarshalJSON encode the value to JSON.
+func (u UUID) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+	b := make([]byte, 0, 38)
+	b = append(b, '"')
+	b = appendUUID(b, u.UUID)
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON decode data to the value.
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	var str *string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	if str == nil {
+		u.UUID, u.Valid = [16]byte{}, false
+		return nil
+	}
+	return u.Scan(*str)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (u UUID) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return []byte{}, nil
+	}
+	return []byte(formatUUID(u.UUID)), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null.
+func (u *UUID) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		u.UUID, u.Valid = [16]byte{}, false
+		return nil
+	}
+	return u.Scan(string(text))
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (u UUID) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, u.Valid, u)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (u *UUID) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, u)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (u UUID) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, u.Valid, u)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (u *UUID) UnmarshalXMLAttr(attr xml.Attr) error {
+	return u.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (u UUID) MarshalYAML() (interface{}, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return formatUUID(u.UUID), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (u *UUID) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str *string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if str == nil {
+		u.UUID, u.Valid = [16]byte{}, false
+		return nil
+	}
+	return u.Scan(*str)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (u UUID) LogValue() slog.Value {
+	if !u.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(formatUUID(u.UUID))
+}
+
+// Format implements the fmt.Formatter interface.
+func (u UUID) Format(state fmt.State, verb rune) {
+	format(state, verb, u, u.Valid, formatUUID(u.UUID))
+}
+
+// String implements the fmt.Stringer interface.
+func (u UUID) String() string {
+	return fmt.Sprint(u)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (u UUID) Ptr() *[16]byte {
+	if !u.Valid {
+		return nil
+	}
+	v := u.UUID
+	return &v
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (u UUID) ValueOrZero() [16]byte {
+	if !u.Valid {
+		var zero [16]byte
+		return zero
+	}
+	return u.UUID
+}
+
+// ValueOr returns the value, or v if it is null.
+func (u UUID) ValueOr(v [16]byte) [16]byte {
+	if !u.Valid {
+		return v
+	}
+	return u.UUID
+}
+
+// IsNull returns true if Valid is false.
+func (u *UUID) IsNull() bool {
+	return !u.Valid
+}
+
+func parseUUID(s string) ([16]byte, error) {
+	var uuid [16]byte
+	text := s
+	switch {
+	case len(text) == 45 && strings.EqualFold(text[:9], "urn:uuid:"):
+		text = text[9:]
+	case len(text) == 38 && text[0] == '{' && text[37] == '}':
+		text = text[1:37]
+	}
+	if len(text) == 36 {
+		if text[8] != '-' || text[13] != '-' || text[18] != '-' || text[23] != '-' {
+			return uuid, fmt.Errorf("invalid UUID: %q", s)
+		}
+		text = text[:8] + text[9:13] + text[14:18] + text[19:23] + text[24:]
+	}
+	if len(text) != 32 {
+		return uuid, fmt.Errorf("invalid UUID: %q", s)
+	}
+	if _, err := hex.Decode(uuid[:], []byte(text)); err != nil {
+		return uuid, fmt.Errorf("invalid UUID: %q", s)
+	}
+	return uuid, nil
+}
+
+func formatUUID(uuid [16]byte) string {
+	return string(appendUUID(make([]byte, 0, 36), uuid))
+}
+
+// appendUUID appends the canonical text form of uuid to dst.
+func appendUUID(dst []byte, uuid [16]byte) []byte {
+	var buf [36]byte
+	hex.Encode(buf[0:8], uuid[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], uuid[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], uuid[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], uuid[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], uuid[10:])
+	return append(dst, buf[:]...)
+}
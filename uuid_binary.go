@@ -0,0 +1,46 @@
+package null
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// UUIDBinary represents a UUID that may be null and is passed to the driver as its 16 bytes,
+// e.g. for a MySQL BINARY(16) column. It behaves like UUID in every other respect.
+type UUIDBinary struct {
+	UUID
+}
+
+// NewUUIDBinary creates a new UUIDBinary
+func NewUUIDBinary(u [16]byte, valid bool) UUIDBinary {
+	return UUIDBinary{UUID: NewUUID(u, valid)}
+}
+
+// UUIDBinaryFrom creates a new UUIDBinary that is always valid.
+func UUIDBinaryFrom(u [16]byte) UUIDBinary {
+	return NewUUIDBinary(u, true)
+}
+
+// UUIDBinaryFromPtr creates a new UUIDBinary that is null if u is nil.
+func UUIDBinaryFromPtr(u *[16]byte) UUIDBinary {
+	return UUIDBinary{UUID: UUIDFromPtr(u)}
+}
+
+// Value implements the driver Valuer interface.
+// The value is the 16 bytes of the UUID.
+func (u UUIDBinary) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return u.UUID.UUID[:], nil
+}
+
+// Format implements the fmt.Formatter interface.
+func (u UUIDBinary) Format(state fmt.State, verb rune) {
+	format(state, verb, u, u.Valid, formatUUID(u.UUID.UUID))
+}
+
+// String implements the fmt.Stringer interface.
+func (u UUIDBinary) String() string {
+	return fmt.Sprint(u)
+}
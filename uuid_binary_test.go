@@ -0,0 +1,87 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestUUIDBinaryValue(t *testing.T) {
+	val := NewUUIDBinary(testUUID, true)
+	got, err := val.Value()
+	if !reflect.DeepEqual(got, testUUID[:]) || err != nil {
+		t.Fatalf("want %v, but %v:", testUUID[:], got)
+	}
+}
+
+func TestUUIDBinaryValueNull(t *testing.T) {
+	val := NewUUIDBinary(testUUID, false)
+	got, err := val.Value()
+	if got != nil || err != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestUUIDBinaryScan(t *testing.T) {
+	val := UUIDBinary{}
+	if err := val.Scan(testUUID[:]); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUUIDBinary(testUUID, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUUIDBinaryMixedColumns(t *testing.T) {
+	row := struct {
+		ID    UUIDBinary
+		Token UUID
+	}{NewUUIDBinary(testUUID, true), NewUUID(testUUID, true)}
+
+	id, err := row.ID.Value()
+	if !reflect.DeepEqual(id, testUUID[:]) || err != nil {
+		t.Fatalf("want %v, but %v:", testUUID[:], id)
+	}
+	token, err := row.Token.Value()
+	if token != testUUIDText || err != nil {
+		t.Fatalf("want %v, but %v:", testUUIDText, token)
+	}
+}
+
+func TestUUIDBinaryMarshalJSON(t *testing.T) {
+	got, err := json.Marshal(NewUUIDBinary(testUUID, true))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `"` + testUUIDText + `"`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestUUIDBinaryFormat(t *testing.T) {
+	val := NewUUIDBinary(testUUID, true)
+	if got := fmt.Sprint(val); got != testUUIDText {
+		t.Fatalf("want %v, but %v:", testUUIDText, got)
+	}
+
+	want := fmt.Sprintf("null.UUIDBinary{UUID:%#v}", NewUUID(testUUID, true))
+	if got := fmt.Sprintf("%#v", val); got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUUIDBinaryFromPtr(t *testing.T) {
+	if got := UUIDBinaryFromPtr(nil); got != (UUIDBinary{}) {
+		t.Fatalf("want %v, but %v:", UUIDBinary{}, got)
+	}
+
+	u := testUUID
+	if got, want := UUIDBinaryFromPtr(&u), UUIDBinaryFrom(testUUID); got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
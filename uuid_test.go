@@ -0,0 +1,300 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+var testUUID = [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+const testUUIDText = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+func TestUUIDScanNull(t *testing.T) {
+	val := NewUUID(testUUID, true)
+	if err := val.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUUID([16]byte{}, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUUIDScanString(t *testing.T) {
+	for _, in := range []string{
+		testUUIDText,
+		"6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"URN:UUID:6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+		"6ba7b8109dad11d180b400c04fd430c8",
+	} {
+		val := UUID{}
+		if err := val.Scan(in); err != nil {
+			t.Fatal(err)
+		}
+
+		want := NewUUID(testUUID, true)
+		if val != want {
+			t.Fatalf("want %v, but %v:", want, val)
+		}
+	}
+}
+
+func TestUUIDScanByte(t *testing.T) {
+	val := UUID{}
+	if err := val.Scan([]byte(testUUIDText)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUUID(testUUID, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUUIDScanBinary(t *testing.T) {
+	val := UUID{}
+	if err := val.Scan(testUUID[:]); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUUID(testUUID, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUUIDScanStringParseError(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c",
+		"6ba7b810x9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430cg",
+		"(6ba7b810-9dad-11d1-80b4-00c04fd430c8)",
+		"urn:uid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b810-9dad11d1-80b4-00c04fd430c8",
+	} {
+		val := UUID{}
+		err := val.Scan(in)
+		if err == nil || err.Error() != `cannot scan string into UUID: invalid UUID: "`+in+`"` {
+			t.Fatalf("want %v, but %v:", `cannot scan string into UUID: invalid UUID: "`+in+`"`, err)
+		}
+	}
+}
+
+func TestUUIDScanTypeError(t *testing.T) {
+	val := UUID{}
+	err := val.Scan(struct{}{})
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+}
+
+func TestParseUUID(t *testing.T) {
+	got, err := ParseUUID(testUUIDText)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUUID(testUUID, true)
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+
+	if _, err := ParseUUID("foo"); err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestUUIDValue(t *testing.T) {
+	val := NewUUID(testUUID, true)
+	got, err := val.Value()
+	if got != testUUIDText || err != nil {
+		t.Fatalf("want %v, but %v:", testUUIDText, got)
+	}
+}
+
+func TestUUIDValueNull(t *testing.T) {
+	val := NewUUID(testUUID, false)
+	got, err := val.Value()
+	if got != nil || err != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestUUIDMarshalJSON(t *testing.T) {
+	val := NewUUID(testUUID, true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `"` + testUUIDText + `"`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUUIDMarshalJSONNull(t *testing.T) {
+	val := NewUUID(testUUID, false)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "null"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUUIDUnmarshalJSON(t *testing.T) {
+	var val UUID
+	err := json.NewDecoder(strings.NewReader(`"{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}"`)).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUUID(testUUID, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUUIDUnmarshalJSONNull(t *testing.T) {
+	val := NewUUID(testUUID, true)
+	err := json.NewDecoder(strings.NewReader("null")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUUID([16]byte{}, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUUIDUnmarshalJSONError(t *testing.T) {
+	for _, data := range []string{"foo", `"foo"`, "1"} {
+		val := UUID{}
+		if err := val.UnmarshalJSON([]byte(data)); err == nil {
+			t.Fatalf("no error message is output: %v", data)
+		}
+	}
+}
+
+func TestUUIDMarshalText(t *testing.T) {
+	val := NewUUID(testUUID, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != testUUIDText {
+		t.Fatalf("want %v, but %v:", testUUIDText, string(got))
+	}
+}
+
+func TestUUIDUnmarshalText(t *testing.T) {
+	var val UUID
+	if err := val.UnmarshalText([]byte(testUUIDText)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUUID(testUUID, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUUIDUnmarshalTextEmpty(t *testing.T) {
+	val := NewUUID(testUUID, true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewUUID([16]byte{}, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUUIDString(t *testing.T) {
+	val := NewUUID(testUUID, true)
+	if got := val.String(); got != testUUIDText {
+		t.Fatalf("want %v, but %v:", testUUIDText, got)
+	}
+}
+
+func TestUUIDIsNull(t *testing.T) {
+	val := NewUUID([16]byte{}, true)
+	if val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+
+	val = NewUUID(testUUID, false)
+	if !val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+}
+
+func TestUUIDFrom(t *testing.T) {
+	val := UUIDFrom([16]byte{1, 2, 3})
+	want := NewUUID([16]byte{1, 2, 3}, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUUIDFromPtr(t *testing.T) {
+	v := [16]byte{1, 2, 3}
+	val := UUIDFromPtr(&v)
+	want := NewUUID([16]byte{1, 2, 3}, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = UUIDFromPtr(nil)
+	want = UUID{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestUUIDPtr(t *testing.T) {
+	val := NewUUID([16]byte{1, 2, 3}, true)
+	got := val.Ptr()
+	if got == nil || *got != [16]byte{1, 2, 3} {
+		t.Fatalf("want %v, but %v:", [16]byte{1, 2, 3}, got)
+	}
+
+	val = NewUUID([16]byte{1, 2, 3}, false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestUUIDValueOr(t *testing.T) {
+	val := NewUUID([16]byte{1, 2, 3}, true)
+	if got := val.ValueOrZero(); got != [16]byte{1, 2, 3} {
+		t.Fatalf("want %v, but %v:", [16]byte{1, 2, 3}, got)
+	}
+	if got := val.ValueOr([16]byte{4, 5, 6}); got != [16]byte{1, 2, 3} {
+		t.Fatalf("want %v, but %v:", [16]byte{1, 2, 3}, got)
+	}
+
+	val = NewUUID([16]byte{1, 2, 3}, false)
+	if got := val.ValueOrZero(); got != [16]byte{} {
+		t.Fatalf("want %v, but %v:", [16]byte{}, got)
+	}
+	if got := val.ValueOr([16]byte{4, 5, 6}); got != [16]byte{4, 5, 6} {
+		t.Fatalf("want %v, but %v:", [16]byte{4, 5, 6}, got)
+	}
+}
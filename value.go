@@ -0,0 +1,490 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Value represents a value of any type T that may be null.
+// It can be used to give domain types (UUIDs, enums, money, ...) the same
+// behavior as the named types of this package.
+type Value[T any] struct {
+	V     T
+	Valid bool
+}
+
+// NewValue creates a new Value
+func NewValue[T any](v T, valid bool) Value[T] {
+	return Value[T]{V: v, Valid: valid}
+}
+
+// ValueFrom creates a new Value that is always valid.
+func ValueFrom[T any](v T) Value[T] {
+	return NewValue(v, true)
+}
+
+// ValueFromPtr creates a new Value that is null if v is nil.
+func ValueFromPtr[T any](v *T) Value[T] {
+	if v == nil {
+		return Value[T]{}
+	}
+	return NewValue(*v, true)
+}
+
+// Scan implements the Scanner interface.
+// If T is a string, bool, number or time.Time, the value is scanned like the
+// named type of this package does, e.g. Value[int8] like Int8.
+// Otherwise, if *T implements the Scanner interface, the value is delegated to it,
+// or else the value is assigned directly, or converted when the
+// source and T are both strings, []byte, bools or numbers.
+func (n *Value[T]) Scan(value interface{}) error {
+	return n.scan(n.typeName(), value)
+}
+
+// scan is Scan reporting errors for the type named target.
+func (n *Value[T]) scan(target string, value interface{}) error {
+	if value == nil {
+		var zero T
+		n.V, n.Valid = zero, false
+		return nil
+	}
+
+	var v T
+	var err error
+	switch p := interface{}(&v).(type) {
+	case *string:
+		*p, err = scanString(value)
+	case *bool:
+		*p, err = scanBool(value)
+	case *int:
+		*p, err = scanSigned[int](value, target, strconv.IntSize)
+	case *int8:
+		*p, err = scanSigned[int8](value, target, 8)
+	case *int16:
+		*p, err = scanSigned[int16](value, target, 16)
+	case *int32:
+		*p, err = scanSigned[int32](value, target, 32)
+	case *int64:
+		*p, err = scanSigned[int64](value, target, 64)
+	case *uint:
+		*p, err = scanUnsigned[uint](value, target, strconv.IntSize)
+	case *uint8:
+		*p, err = scanUnsigned[uint8](value, target, 8)
+	case *uint16:
+		*p, err = scanUnsigned[uint16](value, target, 16)
+	case *uint32:
+		*p, err = scanUnsigned[uint32](value, target, 32)
+	case *uint64:
+		*p, err = scanUnsigned[uint64](value, target, 64)
+	case *float32:
+		*p, err = scanFloat[float32](value, 32)
+	case *float64:
+		*p, err = scanFloat[float64](value, 64)
+	case *time.Time:
+		*p, err = scanTime(value)
+	default:
+		if scanner, ok := p.(sql.Scanner); ok {
+			err = scanner.Scan(value)
+		} else if data, ok := value.(T); ok {
+			// The driver owns the memory of a []byte, so keep a copy of it.
+			if b, ok := value.([]byte); ok {
+				data = interface{}(bytes.Clone(b)).(T)
+			}
+			v = data
+		} else {
+			err = convertAssign(&v, value)
+		}
+	}
+	if err != nil {
+		return newScanError(target, value, err)
+	}
+	n.V, n.Valid = v, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// If T implements the Valuer interface, the call is delegated to it.
+// Since driver.Value cannot hold a uint or uint64, such values greater than
+// math.MaxInt64 are returned as a decimal string.
+func (n Value[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	switch v := interface{}(n.V).(type) {
+	case uint:
+		return uint64Value(uint64(v)), nil
+	case uint64:
+		return uint64Value(v), nil
+	}
+	if valuer, ok := interface{}(n.V).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	return driver.DefaultParameterConverter.ConvertValue(n.V)
+}
+
+// MarshalJSON encode the value to JSON.
+func (n Value[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	switch v := interface{}(n.V).(type) {
+	case string:
+		return marshalJSONString(v), nil
+	case bool:
+		return marshalJSONBool(v), nil
+	case int:
+		return marshalJSONInt(int64(v)), nil
+	case int8:
+		return marshalJSONInt(int64(v)), nil
+	case int16:
+		return marshalJSONInt(int64(v)), nil
+	case int32:
+		return marshalJSONInt(int64(v)), nil
+	case int64:
+		return marshalJSONInt(v), nil
+	case uint:
+		return marshalJSONUint(uint64(v)), nil
+	case uint8:
+		return marshalJSONUint(uint64(v)), nil
+	case uint16:
+		return marshalJSONUint(uint64(v)), nil
+	case uint32:
+		return marshalJSONUint(uint64(v)), nil
+	case uint64:
+		return marshalJSONUint(v), nil
+	case float32:
+		return marshalJSONFloat(float64(v), 32)
+	case float64:
+		return marshalJSONFloat(v, 64)
+	case time.Time:
+		return v.MarshalJSON()
+	}
+	return jsonMarshal(n.V)
+}
+
+// UnmarshalJSON decode data to the value.
+// If T is a string, bool or number, LenientJSON applies as it does to the named type.
+func (n *Value[T]) UnmarshalJSON(data []byte) error {
+	return n.unmarshalJSON(n.typeName(), data)
+}
+
+// unmarshalJSON is UnmarshalJSON reporting errors for the type named target.
+func (n *Value[T]) unmarshalJSON(target string, data []byte) error {
+	var t T
+	switch interface{}(&t).(type) {
+	case *string:
+		if text, ok := lenientJSONLiteral(data); ok {
+			return n.scan(target, text)
+		}
+	case *bool, *int, *int8, *int16, *int32, *int64,
+		*uint, *uint8, *uint16, *uint32, *uint64, *float32, *float64:
+		if str, ok := lenientJSONString(data); ok {
+			return n.scan(target, str)
+		}
+	}
+	if valid, ok := decodeJSONValue(interface{}(&t), data); ok {
+		n.V, n.Valid = t, valid
+		return nil
+	}
+
+	var v *T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	n.Valid = v != nil
+	if n.Valid {
+		n.V = *v
+	} else {
+		var zero T
+		n.V = zero
+	}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Null is encoded as empty text.
+func (n Value[T]) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return marshalText(n.V)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as null, other text is decoded like Scan decodes a string.
+func (n *Value[T]) UnmarshalText(text []byte) error {
+	return n.unmarshalText(n.typeName(), text)
+}
+
+// unmarshalText is UnmarshalText reporting errors for the type named target.
+func (n *Value[T]) unmarshalText(target string, text []byte) error {
+	if len(text) == 0 {
+		var zero T
+		n.V, n.Valid = zero, false
+		return nil
+	}
+	return n.scan(target, string(text))
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (n Value[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.Valid, n)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (n *Value[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, n)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (n Value[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.Valid, n)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (n *Value[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return n.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+func (n Value[T]) MarshalYAML() (interface{}, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.V, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also supported by gopkg.in/yaml.v3.
+// Note that gopkg.in/yaml.v3 leaves the field unchanged instead of calling UnmarshalYAML
+// for a null value, so ~ or null is only read as null into a zero value.
+func (n *Value[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *T
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	n.Valid = v != nil
+	if n.Valid {
+		n.V = *v
+	} else {
+		var zero T
+		n.V = zero
+	}
+	return nil
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Null is logged as nil.
+func (n Value[T]) LogValue() slog.Value {
+	if !n.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(n.V)
+}
+
+// Format implements the fmt.Formatter interface.
+func (n Value[T]) Format(state fmt.State, verb rune) {
+	format(state, verb, n, n.Valid, n.V)
+}
+
+// String implements the fmt.Stringer interface.
+func (n Value[T]) String() string {
+	return fmt.Sprint(n)
+}
+
+// Ptr returns a pointer to the value, or nil if it is null.
+func (n Value[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	v := n.V
+	return &v
+}
+
+// ValueOrZero returns the value, or the zero value if it is null.
+func (n Value[T]) ValueOrZero() T {
+	if !n.Valid {
+		var zero T
+		return zero
+	}
+	return n.V
+}
+
+// ValueOr returns the value, or v if it is null.
+func (n Value[T]) ValueOr(v T) T {
+	if !n.Valid {
+		return v
+	}
+	return n.V
+}
+
+// IsNull returns true if Valid is false.
+func (n *Value[T]) IsNull() bool {
+	return !n.Valid
+}
+
+// typeName returns the name of T, even if T is an interface type.
+func (n *Value[T]) typeName() string {
+	return reflect.TypeOf(&n.V).Elem().String()
+}
+
+// uint64Value returns u as an int64, or as a decimal string if it is greater than math.MaxInt64.
+func uint64Value(u uint64) driver.Value {
+	if u > math.MaxInt64 {
+		return strconv.FormatUint(u, 10)
+	}
+	return int64(u)
+}
+
+// convertAssign stores src in the value pointed to by dest, converting
+// between strings, []byte, bools and numbers of any size.
+func convertAssign(dest interface{}, src interface{}) error {
+	dv := reflect.ValueOf(dest).Elem()
+	sv := reflect.ValueOf(src)
+
+	var text string
+	switch data := src.(type) {
+	case string:
+		text = data
+	case []byte:
+		text = string(data)
+	default:
+		if u, ok := dest.(encoding.TextUnmarshaler); ok && sv.Kind() == reflect.String {
+			return u.UnmarshalText([]byte(sv.String()))
+		}
+		if !sv.Type().ConvertibleTo(dv.Type()) || !sameKindFamily(sv.Kind(), dv.Kind()) {
+			return ErrUnsupportedType
+		}
+		if sv.CanFloat() && !dv.CanFloat() && sv.Float() != math.Trunc(sv.Float()) {
+			return fmt.Errorf("%w for %s: %v", ErrFractional, dv.Type(), src)
+		}
+		converted := sv.Convert(dv.Type())
+		if isNegative(sv) && isUnsigned(dv.Kind()) || converted.Convert(sv.Type()).Interface() != src {
+			return &OverflowError{Target: dv.Type().String(), Value: src}
+		}
+		dv.Set(converted)
+		return nil
+	}
+
+	if u, ok := dest.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(text))
+	}
+	switch dv.Kind() {
+	case reflect.String:
+		dv.SetString(text)
+		return nil
+	case reflect.Slice:
+		if dv.Type().Elem().Kind() == reflect.Uint8 {
+			dv.SetBytes([]byte(text))
+			return nil
+		}
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		dv.SetBool(b)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, dv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dv.SetInt(i)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(text, 10, dv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dv.SetUint(u)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(text, dv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dv.SetFloat(f)
+		return nil
+	}
+	return ErrUnsupportedType
+}
+
+// marshalText returns the text form of v, which must implement
+// encoding.TextMarshaler or be a string, []byte, bool or number.
+func marshalText(v interface{}) ([]byte, error) {
+	if m, ok := v.(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return []byte(rv.String()), nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return rv.Bytes(), nil
+		}
+	case reflect.Bool:
+		return strconv.AppendBool(nil, rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.AppendUint(nil, rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	}
+	return nil, fmt.Errorf("%w: %T", ErrUnsupportedType, v)
+}
+
+// sameKindFamily reports whether a value of kind src may be converted to
+// kind dest without changing its meaning, e.g. int to float but not int to string.
+func sameKindFamily(src, dest reflect.Kind) bool {
+	family := func(k reflect.Kind) int {
+		switch k {
+		case reflect.Bool:
+			return 1
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			return 2
+		case reflect.String:
+			return 3
+		default:
+			return 0
+		}
+	}
+	f := family(src)
+	return f != 0 && f == family(dest)
+}
+
+// isNegative reports whether v holds a number less than zero.
+func isNegative(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() < 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() < 0
+	default:
+		return false
+	}
+}
+
+// isUnsigned reports whether k is an unsigned integer kind.
+func isUnsigned(k reflect.Kind) bool {
+	switch k {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,15 @@
+//go:build go1.22
+
+package null
+
+import "database/sql"
+
+// ValueFromSQL creates a new Value from a sql.Null.
+func ValueFromSQL[T any](n sql.Null[T]) Value[T] {
+	return NewValue(n.V, n.Valid)
+}
+
+// ToSQL returns the value as a sql.Null.
+func (n Value[T]) ToSQL() sql.Null[T] {
+	return sql.Null[T]{V: n.V, Valid: n.Valid}
+}
@@ -0,0 +1,30 @@
+//go:build go1.22
+
+package null
+
+import (
+	"database/sql"
+	"testing"
+)
+
+func TestValueFromSQL(t *testing.T) {
+	val := ValueFromSQL(sql.Null[testStatus]{V: "active", Valid: true})
+	want := NewValue(testStatus("active"), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = ValueFromSQL(sql.Null[testStatus]{V: "active", Valid: false})
+	want = NewValue(testStatus("active"), false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestValueToSQL(t *testing.T) {
+	got := NewValue(testStatus("active"), true).ToSQL()
+	want := sql.Null[testStatus]{V: "active", Valid: true}
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
@@ -0,0 +1,455 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testStatus string
+
+type testID struct {
+	id int64
+}
+
+func (i *testID) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("not an int64")
+	}
+	i.id = v
+	return nil
+}
+
+func (i testID) Value() (driver.Value, error) {
+	return "id-" + string(rune('0'+i.id)), nil
+}
+
+func TestValueScanNull(t *testing.T) {
+	val := NewValue("foo", true)
+	if err := val.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewValue("", false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestValueScanSameType(t *testing.T) {
+	val := Value[string]{}
+	if err := val.Scan("foo"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewValue("foo", true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestValueScanCopiesBytes(t *testing.T) {
+	buf := []byte("foo")
+	val := Value[[]byte]{}
+	if err := val.Scan(buf); err != nil {
+		t.Fatal(err)
+	}
+	anyVal := Value[interface{}]{}
+	if err := anyVal.Scan(buf); err != nil {
+		t.Fatal(err)
+	}
+	copy(buf, "xyz")
+
+	want := "foo"
+	if string(val.V) != want {
+		t.Fatalf("want %v, but %v:", want, string(val.V))
+	}
+	if got := string(anyVal.V.([]byte)); got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestValueScanNamedString(t *testing.T) {
+	val := Value[testStatus]{}
+	if err := val.Scan([]byte("active")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewValue(testStatus("active"), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestValueScanStringToInt(t *testing.T) {
+	val := Value[int32]{}
+	if err := val.Scan("42"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewValue(int32(42), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestValueScanIntConversion(t *testing.T) {
+	val := Value[uint16]{}
+	if err := val.Scan(int64(42)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewValue(uint16(42), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestValueScanIntOutOfRange(t *testing.T) {
+	val := Value[uint8]{}
+	err := val.Scan(int64(-1))
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
+	}
+
+	err = val.Scan(int64(256))
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("want %v, but %v:", ErrOutOfRange, err)
+	}
+}
+
+func TestValueScanScanner(t *testing.T) {
+	val := Value[testID]{}
+	if err := val.Scan(int64(1)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewValue(testID{id: 1}, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestValueScanParseError(t *testing.T) {
+	val := Value[int]{}
+	err := val.Scan("foo")
+	if err == nil {
+		t.Fatalf("no error is output")
+	}
+}
+
+func TestValueScanTypeError(t *testing.T) {
+	val := Value[string]{}
+	err := val.Scan(int64(1))
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+}
+
+func TestValueScanLikeNamedType(t *testing.T) {
+	b := Value[bool]{}
+	if err := b.Scan(int64(1)); err != nil {
+		t.Fatal(err)
+	}
+	if b != NewValue(true, true) {
+		t.Fatalf("want %v, but %v:", NewValue(true, true), b)
+	}
+
+	tm := Value[time.Time]{}
+	if err := tm.Scan("2022-12-31 23:59:59"); err != nil {
+		t.Fatal(err)
+	}
+	if tm != NewValue(testTime, true) {
+		t.Fatalf("want %v, but %v:", NewValue(testTime, true), tm)
+	}
+
+	f := Value[float64]{}
+	if err := f.Scan(float32(1.5)); err != nil {
+		t.Fatal(err)
+	}
+	if f != NewValue(1.5, true) {
+		t.Fatalf("want %v, but %v:", NewValue(1.5, true), f)
+	}
+}
+
+func TestValueScanErrorTarget(t *testing.T) {
+	val := Value[int8]{}
+	err := val.Scan(int64(128))
+	want := "cannot scan int64 into int8: maximum or minimum value of int8 exceeded: 128"
+	if err == nil || err.Error() != want {
+		t.Fatalf("want %v, but %v:", want, err)
+	}
+
+	i := Int8{}
+	err = i.Scan(int64(128))
+	want = "cannot scan int64 into Int8: maximum or minimum value of Int8 exceeded: 128"
+	if err == nil || err.Error() != want {
+		t.Fatalf("want %v, but %v:", want, err)
+	}
+}
+
+func TestValueValue(t *testing.T) {
+	val := NewValue(testStatus("active"), true)
+	got, err := val.Value()
+	if got != "active" || err != nil {
+		t.Fatalf("want %v, but %v:", "active", got)
+	}
+}
+
+func TestValueValueInt(t *testing.T) {
+	val := NewValue(uint8(1), true)
+	got, err := val.Value()
+	if got != int64(1) || err != nil {
+		t.Fatalf("want %v, but %v:", int64(1), got)
+	}
+}
+
+func TestValueValueUint64(t *testing.T) {
+	val := NewValue(uint64(math.MaxUint64), true)
+	got, err := val.Value()
+	if got != "18446744073709551615" || err != nil {
+		t.Fatalf("want %v, but %v:", "18446744073709551615", got)
+	}
+
+	val = NewValue(uint64(math.MaxInt64), true)
+	got, err = val.Value()
+	if got != int64(math.MaxInt64) || err != nil {
+		t.Fatalf("want %v, but %v:", int64(math.MaxInt64), got)
+	}
+}
+
+func TestValueValueValuer(t *testing.T) {
+	val := NewValue(testID{id: 1}, true)
+	got, err := val.Value()
+	if got != "id-1" || err != nil {
+		t.Fatalf("want %v, but %v:", "id-1", got)
+	}
+}
+
+func TestValueValueNull(t *testing.T) {
+	val := NewValue("foo", false)
+	got, err := val.Value()
+	if got != nil || err != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestValueMarshalJSON(t *testing.T) {
+	val := NewValue(testStatus("active"), true)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `"active"`
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestValueMarshalJSONNull(t *testing.T) {
+	val := NewValue(1, false)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(val); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "null"
+	got := strings.TrimSpace(buf.String())
+	if got != want {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestValueUnmarshalJSON(t *testing.T) {
+	var val Value[int]
+	err := json.NewDecoder(strings.NewReader("1")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewValue(1, true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestValueUnmarshalJSONNull(t *testing.T) {
+	val := NewValue(1, true)
+	err := json.NewDecoder(strings.NewReader("null")).Decode(&val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewValue(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestValueUnmarshalJSONError(t *testing.T) {
+	val := Value[int]{}
+	err := val.UnmarshalJSON([]byte(`"foo"`))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestValueUnmarshalJSONLenient(t *testing.T) {
+	defer func(b bool) { LenientJSON = b }(LenientJSON)
+	LenientJSON = true
+
+	i := Value[int]{}
+	if err := i.UnmarshalJSON([]byte(`"42"`)); err != nil {
+		t.Fatal(err)
+	}
+	if i != NewValue(42, true) {
+		t.Fatalf("want %v, but %v:", NewValue(42, true), i)
+	}
+
+	s := Value[string]{}
+	if err := s.UnmarshalJSON([]byte(`true`)); err != nil {
+		t.Fatal(err)
+	}
+	if s != NewValue("true", true) {
+		t.Fatalf("want %v, but %v:", NewValue("true", true), s)
+	}
+}
+
+func TestValueMarshalText(t *testing.T) {
+	val := NewValue(1.5, true)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "1.5"
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestValueMarshalTextNull(t *testing.T) {
+	val := NewValue(testStatus("active"), false)
+	got, err := val.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "" {
+		t.Fatalf("want %v, but %v:", "", string(got))
+	}
+}
+
+func TestValueMarshalTextError(t *testing.T) {
+	val := NewValue(struct{}{}, true)
+	_, err := val.MarshalText()
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("want %v, but %v:", ErrUnsupportedType, err)
+	}
+}
+
+func TestValueUnmarshalText(t *testing.T) {
+	var val Value[uint8]
+	if err := val.UnmarshalText([]byte("255")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewValue(uint8(255), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestValueUnmarshalTextEmpty(t *testing.T) {
+	val := NewValue(testStatus("active"), true)
+	if err := val.UnmarshalText([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewValue(testStatus(""), false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestValueUnmarshalTextError(t *testing.T) {
+	val := Value[uint8]{}
+	err := val.UnmarshalText([]byte("256"))
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestValueIsNull(t *testing.T) {
+	val := NewValue(0, true)
+	if val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+
+	val = NewValue(0, false)
+	if !val.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+}
+
+func TestValueFrom(t *testing.T) {
+	val := ValueFrom(testStatus("active"))
+	want := NewValue(testStatus("active"), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestValueFromPtr(t *testing.T) {
+	v := testStatus("active")
+	val := ValueFromPtr(&v)
+	want := NewValue(testStatus("active"), true)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+
+	val = ValueFromPtr[testStatus](nil)
+	want = Value[testStatus]{}
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
+
+func TestValuePtr(t *testing.T) {
+	val := NewValue(1, true)
+	got := val.Ptr()
+	if got == nil || *got != 1 {
+		t.Fatalf("want %v, but %v:", 1, got)
+	}
+
+	val = NewValue(1, false)
+	if got := val.Ptr(); got != nil {
+		t.Fatalf("want %v, but %v:", nil, got)
+	}
+}
+
+func TestValueValueOr(t *testing.T) {
+	val := NewValue(1, true)
+	if got := val.ValueOrZero(); got != 1 {
+		t.Fatalf("want %v, but %v:", 1, got)
+	}
+	if got := val.ValueOr(2); got != 1 {
+		t.Fatalf("want %v, but %v:", 1, got)
+	}
+
+	val = NewValue(1, false)
+	if got := val.ValueOrZero(); got != 0 {
+		t.Fatalf("want %v, but %v:", 0, got)
+	}
+	if got := val.ValueOr(2); got != 2 {
+		t.Fatalf("want %v, but %v:", 2, got)
+	}
+}
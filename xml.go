@@ -0,0 +1,87 @@
+package null
+
+import (
+	"database/sql"
+	"encoding"
+	"encoding/xml"
+)
+
+// XMLNullMode is the way null values are encoded to XML elements.
+type XMLNullMode int
+
+const (
+	// XMLNullOmit omits the element.
+	XMLNullOmit XMLNullMode = iota
+	// XMLNullNil encodes the element as empty with the attribute xsi:nil="true".
+	XMLNullNil
+)
+
+// XMLNullEncoding is the way MarshalXML encodes null values.
+// Null attributes are always omitted.
+// With XMLNullOmit, UnmarshalXML decodes an empty element as null. With XMLNullNil, only an element
+// with xsi:nil is null, and an empty element is a valid empty value, such as String "",
+// or an error for a type that has no empty value.
+var XMLNullEncoding = XMLNullOmit
+
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+func marshalXML(e *xml.Encoder, start xml.StartElement, valid bool, m encoding.TextMarshaler) error {
+	if !valid {
+		if XMLNullEncoding != XMLNullNil {
+			return nil
+		}
+		start.Attr = append(start.Attr,
+			xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+			xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"},
+		)
+		return e.EncodeElement("", start)
+	}
+	text, err := m.MarshalText()
+	if err != nil {
+		return err
+	}
+	return e.EncodeElement(string(text), start)
+}
+
+// xmlUnmarshaler decodes the text of an element. Scan is used for empty text,
+// which UnmarshalText decodes as null.
+type xmlUnmarshaler interface {
+	encoding.TextUnmarshaler
+	sql.Scanner
+}
+
+func unmarshalXML(d *xml.Decoder, start xml.StartElement, u xmlUnmarshaler) error {
+	for _, attr := range start.Attr {
+		if isXSINil(attr) {
+			if err := d.Skip(); err != nil {
+				return err
+			}
+			return u.UnmarshalText(nil)
+		}
+	}
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return err
+	}
+	if text == "" && XMLNullEncoding == XMLNullNil {
+		return u.Scan(text)
+	}
+	return u.UnmarshalText([]byte(text))
+}
+
+func isXSINil(attr xml.Attr) bool {
+	return attr.Name.Local == "nil" &&
+		(attr.Name.Space == xsiNamespace || attr.Name.Space == "xsi") &&
+		(attr.Value == "true" || attr.Value == "1")
+}
+
+func marshalXMLAttr(name xml.Name, valid bool, m encoding.TextMarshaler) (xml.Attr, error) {
+	if !valid {
+		return xml.Attr{}, nil
+	}
+	text, err := m.MarshalText()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
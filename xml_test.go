@@ -0,0 +1,216 @@
+package null
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testXML struct {
+	XMLName xml.Name      `xml:"row"`
+	ID      Int64         `xml:"id,attr"`
+	Code    String        `xml:"code,attr"`
+	String  String        `xml:"string"`
+	Bool    Bool          `xml:"bool"`
+	Byte    Byte          `xml:"byte"`
+	Int     Int           `xml:"int"`
+	Int8    Int8          `xml:"int8"`
+	Int16   Int16         `xml:"int16"`
+	Int32   Int32         `xml:"int32"`
+	Int64   Int64         `xml:"int64"`
+	Uint    Uint          `xml:"uint"`
+	Uint8   Uint8         `xml:"uint8"`
+	Uint16  Uint16        `xml:"uint16"`
+	Uint32  Uint32        `xml:"uint32"`
+	Uint64  Uint64        `xml:"uint64"`
+	Float32 Float32       `xml:"float32"`
+	Float64 Float64       `xml:"float64"`
+	Time    Time          `xml:"time"`
+	Bytes   Bytes         `xml:"bytes"`
+	JSON    JSON          `xml:"json"`
+	Value   Value[int]    `xml:"value"`
+	Opt     Optional[int] `xml:"optional"`
+}
+
+func testXMLValid() testXML {
+	return testXML{
+		XMLName: xml.Name{Local: "row"},
+		ID:      NewInt64(1, true),
+		Code:    NewString("a&b", true),
+		String:  NewString("<foo>", true),
+		Bool:    NewBool(true, true),
+		Byte:    NewByte(1, true),
+		Int:     NewInt(-1, true),
+		Int8:    NewInt8(-8, true),
+		Int16:   NewInt16(-16, true),
+		Int32:   NewInt32(-32, true),
+		Int64:   NewInt64(-64, true),
+		Uint:    NewUint(1, true),
+		Uint8:   NewUint8(8, true),
+		Uint16:  NewUint16(16, true),
+		Uint32:  NewUint32(32, true),
+		Uint64:  NewUint64(64, true),
+		Float32: NewFloat32(1.5, true),
+		Float64: NewFloat64(2.5, true),
+		Time:    NewTime(time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC), true),
+		Bytes:   NewBytes([]byte("foo"), true),
+		JSON:    NewJSON(json.RawMessage(`{"foo":1}`), true),
+		Value:   NewValue(1, true),
+		Opt:     NewOptional(2, true),
+	}
+}
+
+const testXMLValidText = `<row id="1" code="a&amp;b">` +
+	`<string>&lt;foo&gt;</string><bool>true</bool><byte>1</byte>` +
+	`<int>-1</int><int8>-8</int8><int16>-16</int16><int32>-32</int32><int64>-64</int64>` +
+	`<uint>1</uint><uint8>8</uint8><uint16>16</uint16><uint32>32</uint32><uint64>64</uint64>` +
+	`<float32>1.5</float32><float64>2.5</float64><time>2022-01-02T03:04:05Z</time>` +
+	`<bytes>Zm9v</bytes><json>{&#34;foo&#34;:1}</json><value>1</value><optional>2</optional></row>`
+
+func TestMarshalXML(t *testing.T) {
+	got, err := xml.Marshal(testXMLValid())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != testXMLValidText {
+		t.Fatalf("want %v, but %v:", testXMLValidText, string(got))
+	}
+}
+
+func TestMarshalXMLNullOmit(t *testing.T) {
+	got, err := xml.Marshal(testXML{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<row></row>`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestMarshalXMLNullNil(t *testing.T) {
+	defer func(m XMLNullMode) { XMLNullEncoding = m }(XMLNullEncoding)
+	XMLNullEncoding = XMLNullNil
+
+	got, err := xml.Marshal(struct {
+		XMLName xml.Name `xml:"row"`
+		ID      Int      `xml:"id,attr"`
+		Name    String   `xml:"name"`
+	}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<row><name xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></name></row>`
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestUnmarshalXML(t *testing.T) {
+	var got testXML
+	if err := xml.Unmarshal([]byte(testXMLValidText), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := testXMLValid()
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUnmarshalXMLNull(t *testing.T) {
+	got := testXMLValid()
+	data := `<row xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">` +
+		`<string xsi:nil="true"/><int xsi:nil="true"></int><time xsi:nil="1"/><int64></int64></row>`
+	if err := xml.Unmarshal([]byte(data), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if !got.String.IsNull() || !got.Int.IsNull() || !got.Time.IsNull() || !got.Int64.IsNull() {
+		t.Fatalf("it has to be null: %v", got)
+	}
+	if got.Bool.IsNull() {
+		t.Fatal("it has to be not null")
+	}
+}
+
+func TestUnmarshalXMLNullNilEmpty(t *testing.T) {
+	defer func(m XMLNullMode) { XMLNullEncoding = m }(XMLNullEncoding)
+	XMLNullEncoding = XMLNullNil
+
+	type row struct {
+		XMLName xml.Name      `xml:"row"`
+		Name    String        `xml:"name"`
+		Note    String        `xml:"note"`
+		Value   Value[string] `xml:"value"`
+	}
+	val := row{Name: NewString("", true), Note: NewString("", false), Value: NewValue("", true)}
+	data, err := xml.Marshal(val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got row
+	if err := xml.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	val.XMLName = xml.Name{Local: "row"}
+	if got != val {
+		t.Fatalf("want %v, but %v:", val, got)
+	}
+
+	var i struct {
+		Int Int `xml:"int"`
+	}
+	if err := xml.Unmarshal([]byte(`<row><int></int></row>`), &i); err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestUnmarshalXMLError(t *testing.T) {
+	var got testXML
+	err := xml.Unmarshal([]byte(`<row><int>foo</int></row>`), &got)
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestUnmarshalXMLAttrError(t *testing.T) {
+	var got testXML
+	err := xml.Unmarshal([]byte(`<row id="foo"></row>`), &got)
+	if err == nil {
+		t.Fatal("no error message is output")
+	}
+}
+
+func TestXMLBytesBinary(t *testing.T) {
+	val := struct {
+		XMLName xml.Name `xml:"row"`
+		Data    Bytes    `xml:"data"`
+		Attr    Bytes    `xml:"attr,attr"`
+	}{Data: BytesFrom([]byte{0xff, 0x00, 0x01}), Attr: BytesFrom([]byte{0xfe})}
+	data, err := xml.Marshal(val)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<row attr="/g=="><data>/wAB</data></row>`
+	if string(data) != want {
+		t.Fatalf("want %v, but %v:", want, string(data))
+	}
+
+	got := val
+	got.Data, got.Attr = Bytes{}, Bytes{}
+	val.XMLName = xml.Name{Local: "row"}
+	if err := xml.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, val) {
+		t.Fatalf("want %v, but %v:", val, got)
+	}
+}
@@ -0,0 +1,21 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUnmarshalYAMLFunc(t *testing.T) {
+	val := NewInt(1, true)
+	err := val.UnmarshalYAML(func(v interface{}) error {
+		return json.Unmarshal([]byte("null"), v)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NewInt(0, false)
+	if val != want {
+		t.Fatalf("want %v, but %v:", want, val)
+	}
+}
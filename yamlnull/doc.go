@@ -0,0 +1,3 @@
+// Package yamlnull tests the types of github.com/r-fujiyama/null with gopkg.in/yaml.v3.
+// It is a separate module so that the null module does not depend on gopkg.in/yaml.v3.
+package yamlnull
@@ -0,0 +1,10 @@
+module github.com/r-fujiyama/null/yamlnull
+
+go 1.21
+
+require (
+	github.com/r-fujiyama/null v0.0.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+replace github.com/r-fujiyama/null => ../
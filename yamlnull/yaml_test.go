@@ -0,0 +1,174 @@
+package yamlnull_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/r-fujiyama/null"
+	"gopkg.in/yaml.v3"
+)
+
+type testYAML struct {
+	String  null.String        `yaml:"string"`
+	Bool    null.Bool          `yaml:"bool"`
+	Byte    null.Byte          `yaml:"byte"`
+	Int     null.Int           `yaml:"int"`
+	Int8    null.Int8          `yaml:"int8"`
+	Int16   null.Int16         `yaml:"int16"`
+	Int32   null.Int32         `yaml:"int32"`
+	Int64   null.Int64         `yaml:"int64"`
+	Uint    null.Uint          `yaml:"uint"`
+	Uint8   null.Uint8         `yaml:"uint8"`
+	Uint16  null.Uint16        `yaml:"uint16"`
+	Uint32  null.Uint32        `yaml:"uint32"`
+	Uint64  null.Uint64        `yaml:"uint64"`
+	Float32 null.Float32       `yaml:"float32"`
+	Float64 null.Float64       `yaml:"float64"`
+	Time    null.Time          `yaml:"time"`
+	Bytes   null.Bytes         `yaml:"bytes"`
+	JSON    null.JSON          `yaml:"json"`
+	Value   null.Value[string] `yaml:"value"`
+	Opt     null.Optional[int] `yaml:"optional"`
+}
+
+func testYAMLValid() testYAML {
+	return testYAML{
+		String:  null.NewString("foo", true),
+		Bool:    null.NewBool(true, true),
+		Byte:    null.NewByte(1, true),
+		Int:     null.NewInt(-1, true),
+		Int8:    null.NewInt8(-8, true),
+		Int16:   null.NewInt16(-16, true),
+		Int32:   null.NewInt32(-32, true),
+		Int64:   null.NewInt64(-64, true),
+		Uint:    null.NewUint(1, true),
+		Uint8:   null.NewUint8(8, true),
+		Uint16:  null.NewUint16(16, true),
+		Uint32:  null.NewUint32(32, true),
+		Uint64:  null.NewUint64(18446744073709551615, true),
+		Float32: null.NewFloat32(1.5, true),
+		Float64: null.NewFloat64(2.5, true),
+		Time:    null.NewTime(time.Date(2022, 1, 2, 3, 4, 5, 6, time.UTC), true),
+		Bytes:   null.NewBytes([]byte{0xde, 0xad, 0xbe, 0xef}, true),
+		JSON:    null.NewJSON(json.RawMessage(`{"foo":[1,"bar"]}`), true),
+		Value:   null.NewValue("bar", true),
+		Opt:     null.NewOptional(2, true),
+	}
+}
+
+const testYAMLValidText = `string: foo
+bool: true
+byte: 1
+int: -1
+int8: -8
+int16: -16
+int32: -32
+int64: -64
+uint: 1
+uint8: 8
+uint16: 16
+uint32: 32
+uint64: 18446744073709551615
+float32: 1.5
+float64: 2.5
+time: 2022-01-02T03:04:05.000000006Z
+bytes: 3q2+7w==
+json:
+    foo:
+        - 1
+        - bar
+value: bar
+optional: 2
+`
+
+func TestMarshalYAML(t *testing.T) {
+	got, err := yaml.Marshal(testYAMLValid())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != testYAMLValidText {
+		t.Fatalf("want %v, but %v:", testYAMLValidText, string(got))
+	}
+}
+
+func TestMarshalYAMLNull(t *testing.T) {
+	got, err := yaml.Marshal(struct {
+		String null.String     `yaml:"string"`
+		Int    null.Int        `yaml:"int"`
+		Time   null.Time       `yaml:"time"`
+		JSON   null.JSON       `yaml:"json"`
+		Value  null.Value[int] `yaml:"value"`
+	}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "string: null\nint: null\ntime: null\njson: null\nvalue: null\n"
+	if string(got) != want {
+		t.Fatalf("want %v, but %v:", want, string(got))
+	}
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	var got testYAML
+	if err := yaml.Unmarshal([]byte(testYAMLValidText), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := testYAMLValid()
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+}
+
+func TestUnmarshalYAMLNull(t *testing.T) {
+	var got testYAML
+	data := "string: ~\nbool: false\nint: null\ntime: ~\nbytes: ~\njson: null\nvalue: ~\n"
+	if err := yaml.Unmarshal([]byte(data), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if !got.String.IsNull() || !got.Int.IsNull() || !got.Time.IsNull() ||
+		!got.Bytes.IsNull() || !got.JSON.IsNull() || !got.Value.IsNull() {
+		t.Fatalf("it has to be null: %v", got)
+	}
+	if got.Bool != null.NewBool(false, true) {
+		t.Fatalf("want %v, but %v:", null.NewBool(false, true), got.Bool)
+	}
+}
+
+func TestUnmarshalYAMLError(t *testing.T) {
+	for _, data := range []string{"int8: 128", "uint: -1", "bool: foo", "bytes: '!!'", "time: foo"} {
+		var got testYAML
+		if err := yaml.Unmarshal([]byte(data), &got); err == nil {
+			t.Fatalf("no error message is output: %v", data)
+		}
+	}
+}
+
+func TestUnmarshalYAMLNullPopulated(t *testing.T) {
+	// gopkg.in/yaml.v3 does not call UnmarshalYAML for a null value,
+	// so the fields keep the values they had before decoding.
+	got := testYAMLValid()
+	data := "string: ~\nint: null\ntime: ~\nvalue: ~\noptional: ~\n"
+	if err := yaml.Unmarshal([]byte(data), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := testYAMLValid()
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("want %v, but %v:", want, got)
+	}
+
+	// Decoding into a zero value reads null as null.
+	got = testYAML{}
+	if err := yaml.Unmarshal([]byte(data), &got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.String.IsNull() || !got.Int.IsNull() || !got.Time.IsNull() || !got.Value.IsNull() || got.Opt.Set {
+		t.Fatalf("it has to be null: %v", got)
+	}
+}
@@ -0,0 +1,4 @@
+// Package zero provides types that treat the zero value as null, for columns that
+// store "" or 0 instead of NULL. The zero value is written to the database as NULL
+// and encoded in JSON as null, and NULL and null are read as the zero value.
+package zero
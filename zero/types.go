@@ -0,0 +1,91 @@
+package zero
+
+import "time"
+
+// String is a string whose zero value, "", is NULL in SQL and null in JSON.
+type String = Value[string]
+
+// NewString creates a new String
+func NewString(s string) String {
+	return NewValue(s)
+}
+
+// Bool is a bool whose zero value, false, is NULL in SQL and null in JSON.
+type Bool = Value[bool]
+
+// NewBool creates a new Bool
+func NewBool(b bool) Bool {
+	return NewValue(b)
+}
+
+// Byte is a byte whose zero value, 0, is NULL in SQL and null in JSON.
+type Byte = Value[byte]
+
+// NewByte creates a new Byte
+func NewByte(b byte) Byte {
+	return NewValue(b)
+}
+
+// Int is an int whose zero value, 0, is NULL in SQL and null in JSON.
+type Int = Value[int]
+
+// NewInt creates a new Int
+func NewInt(i int) Int {
+	return NewValue(i)
+}
+
+// Int8 is an int8 whose zero value, 0, is NULL in SQL and null in JSON.
+type Int8 = Value[int8]
+
+// NewInt8 creates a new Int8
+func NewInt8(i int8) Int8 {
+	return NewValue(i)
+}
+
+// Int16 is an int16 whose zero value, 0, is NULL in SQL and null in JSON.
+type Int16 = Value[int16]
+
+// NewInt16 creates a new Int16
+func NewInt16(i int16) Int16 {
+	return NewValue(i)
+}
+
+// Int32 is an int32 whose zero value, 0, is NULL in SQL and null in JSON.
+type Int32 = Value[int32]
+
+// NewInt32 creates a new Int32
+func NewInt32(i int32) Int32 {
+	return NewValue(i)
+}
+
+// Int64 is an int64 whose zero value, 0, is NULL in SQL and null in JSON.
+type Int64 = Value[int64]
+
+// NewInt64 creates a new Int64
+func NewInt64(i int64) Int64 {
+	return NewValue(i)
+}
+
+// Float32 is a float32 whose zero value, 0, is NULL in SQL and null in JSON.
+type Float32 = Value[float32]
+
+// NewFloat32 creates a new Float32
+func NewFloat32(f float32) Float32 {
+	return NewValue(f)
+}
+
+// Float64 is a float64 whose zero value, 0, is NULL in SQL and null in JSON.
+type Float64 = Value[float64]
+
+// NewFloat64 creates a new Float64
+func NewFloat64(f float64) Float64 {
+	return NewValue(f)
+}
+
+// Time is a time.Time whose zero value, the zero time, is NULL in SQL and null in JSON.
+type Time = Value[time.Time]
+
+// NewTime creates a new Time
+func NewTime(t time.Time) Time {
+	return NewValue(t)
+}
@@ -0,0 +1,79 @@
+package zero
+
+import (
+	"database/sql/driver"
+
+	"github.com/r-fujiyama/null"
+)
+
+// Value represents a value of any comparable type T whose zero value is NULL in SQL and null in JSON.
+// NULL and null are read as the zero value.
+type Value[T comparable] struct {
+	V T
+}
+
+// NewValue creates a new Value
+func NewValue[T comparable](v T) Value[T] {
+	return Value[T]{V: v}
+}
+
+// Scan implements the Scanner interface.
+func (v *Value[T]) Scan(value interface{}) error {
+	var n null.Value[T]
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	v.V = n.V
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (v Value[T]) Value() (driver.Value, error) {
+	return v.toNull().Value()
+}
+
+// MarshalJSON encode the value to JSON.
+func (v Value[T]) MarshalJSON() ([]byte, error) {
+	return v.toNull().MarshalJSON()
+}
+
+// UnmarshalJSON decode data to the value.
+func (v *Value[T]) UnmarshalJSON(data []byte) error {
+	var n null.Value[T]
+	if err := n.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	v.V = n.V
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The zero value is encoded as empty text.
+func (v Value[T]) MarshalText() ([]byte, error) {
+	return v.toNull().MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as the zero value.
+func (v *Value[T]) UnmarshalText(text []byte) error {
+	var n null.Value[T]
+	if err := n.UnmarshalText(text); err != nil {
+		return err
+	}
+	v.V = n.V
+	return nil
+}
+
+// IsZero returns true if the value is the zero value.
+// A T with an IsZero method, such as time.Time, decides for itself.
+func (v Value[T]) IsZero() bool {
+	if z, ok := interface{}(v.V).(interface{ IsZero() bool }); ok {
+		return z.IsZero()
+	}
+	var zero T
+	return v.V == zero
+}
+
+func (v Value[T]) toNull() null.Value[T] {
+	return null.NewValue(v.V, !v.IsZero())
+}
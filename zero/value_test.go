@@ -0,0 +1,183 @@
+package zero
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testTime = time.Date(2022, 12, 31, 23, 59, 59, 0, time.UTC)
+
+type testValue interface {
+	sql.Scanner
+	driver.Valuer
+	json.Marshaler
+	json.Unmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+	IsZero() bool
+}
+
+// testValues returns a pointer to a non-zero value of every type, with the value
+// it is scanned from, its driver value and its JSON and text forms.
+func testValues() []struct {
+	val   testValue
+	zero  testValue
+	scan  interface{}
+	value driver.Value
+	json  string
+	text  string
+} {
+	return []struct {
+		val   testValue
+		zero  testValue
+		scan  interface{}
+		value driver.Value
+		json  string
+		text  string
+	}{
+		{ptr(NewString("foo")), &String{}, "foo", "foo", `"foo"`, "foo"},
+		{ptr(NewBool(true)), &Bool{}, true, true, "true", "true"},
+		{ptr(NewByte(1)), &Byte{}, "1", int64(1), "1", "1"},
+		{ptr(NewInt(1)), &Int{}, "1", int64(1), "1", "1"},
+		{ptr(NewInt8(1)), &Int8{}, "1", int64(1), "1", "1"},
+		{ptr(NewInt16(1)), &Int16{}, "1", int64(1), "1", "1"},
+		{ptr(NewInt32(1)), &Int32{}, "1", int64(1), "1", "1"},
+		{ptr(NewInt64(1)), &Int64{}, "1", int64(1), "1", "1"},
+		{ptr(NewFloat32(1.5)), &Float32{}, "1.5", float64(1.5), "1.5", "1.5"},
+		{ptr(NewFloat64(1.5)), &Float64{}, "1.5", float64(1.5), "1.5", "1.5"},
+		{ptr(NewTime(testTime)), &Time{}, testTime, testTime, `"2022-12-31T23:59:59Z"`, "2022-12-31T23:59:59Z"},
+		{ptr(NewValue(testID(1))), &Value[testID]{}, int64(1), int64(1), "1", "1"},
+	}
+}
+
+type testID int64
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestScan(t *testing.T) {
+	for _, tt := range testValues() {
+		got := reflect.New(reflect.TypeOf(tt.zero).Elem()).Interface().(testValue)
+		if err := got.Scan(tt.scan); err != nil {
+			t.Fatalf("%T: %v", got, err)
+		}
+		if !reflect.DeepEqual(got, tt.val) {
+			t.Fatalf("%T: want %v, but %v:", got, tt.val, got)
+		}
+
+		if err := got.Scan(struct{}{}); err == nil {
+			t.Fatalf("%T: no error message is output", got)
+		}
+		if !reflect.DeepEqual(got, tt.val) {
+			t.Fatalf("%T: want %v, but %v:", got, tt.val, got)
+		}
+
+		if err := got.Scan(nil); err != nil {
+			t.Fatalf("%T: %v", got, err)
+		}
+		if !reflect.DeepEqual(got, tt.zero) {
+			t.Fatalf("%T: want %v, but %v:", got, tt.zero, got)
+		}
+	}
+}
+
+func TestValue(t *testing.T) {
+	for _, tt := range testValues() {
+		got, err := tt.val.Value()
+		if !reflect.DeepEqual(got, tt.value) || err != nil {
+			t.Fatalf("%T: want %v, but %v:", tt.val, tt.value, got)
+		}
+
+		got, err = tt.zero.Value()
+		if got != nil || err != nil {
+			t.Fatalf("%T: want %v, but %v:", tt.zero, nil, got)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	for _, tt := range testValues() {
+		got, err := json.Marshal(tt.val)
+		if string(got) != tt.json || err != nil {
+			t.Fatalf("%T: want %v, but %v:", tt.val, tt.json, string(got))
+		}
+
+		got, err = json.Marshal(tt.zero)
+		if string(got) != "null" || err != nil {
+			t.Fatalf("%T: want %v, but %v:", tt.zero, "null", string(got))
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	for _, tt := range testValues() {
+		got := reflect.New(reflect.TypeOf(tt.zero).Elem()).Interface().(testValue)
+		if err := json.Unmarshal([]byte(tt.json), got); err != nil {
+			t.Fatalf("%T: %v", got, err)
+		}
+		if !reflect.DeepEqual(got, tt.val) {
+			t.Fatalf("%T: want %v, but %v:", got, tt.val, got)
+		}
+
+		if err := json.Unmarshal([]byte("null"), got); err != nil {
+			t.Fatalf("%T: %v", got, err)
+		}
+		if !reflect.DeepEqual(got, tt.zero) {
+			t.Fatalf("%T: want %v, but %v:", got, tt.zero, got)
+		}
+	}
+}
+
+func TestMarshalText(t *testing.T) {
+	for _, tt := range testValues() {
+		got, err := tt.val.MarshalText()
+		if string(got) != tt.text || err != nil {
+			t.Fatalf("%T: want %v, but %v:", tt.val, tt.text, string(got))
+		}
+
+		got, err = tt.zero.MarshalText()
+		if string(got) != "" || err != nil {
+			t.Fatalf("%T: want %v, but %v:", tt.zero, "", string(got))
+		}
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	for _, tt := range testValues() {
+		got := reflect.New(reflect.TypeOf(tt.zero).Elem()).Interface().(testValue)
+		if err := got.UnmarshalText([]byte(tt.text)); err != nil {
+			t.Fatalf("%T: %v", got, err)
+		}
+		if !reflect.DeepEqual(got, tt.val) {
+			t.Fatalf("%T: want %v, but %v:", got, tt.val, got)
+		}
+
+		if err := got.UnmarshalText([]byte{}); err != nil {
+			t.Fatalf("%T: %v", got, err)
+		}
+		if !reflect.DeepEqual(got, tt.zero) {
+			t.Fatalf("%T: want %v, but %v:", got, tt.zero, got)
+		}
+	}
+}
+
+func TestIsZero(t *testing.T) {
+	for _, tt := range testValues() {
+		if tt.val.IsZero() {
+			t.Fatalf("%T: it has to be not zero", tt.val)
+		}
+		if !tt.zero.IsZero() {
+			t.Fatalf("%T: it has to be zero", tt.zero)
+		}
+	}
+
+	if !NewTime(time.Time{}.In(time.FixedZone("UTC+9", 9*60*60))).IsZero() {
+		t.Fatal("it has to be zero")
+	}
+}